@@ -0,0 +1,14 @@
+package main
+
+import (
+	"context"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type keyManager interface {
+	CreateKey(ctx context.Context, input dto.CreateAPIKeyInput) (dto.CreateAPIKeyOutput, error)
+	RevokeKey(ctx context.Context, input dto.RevokeAPIKeyInput) error
+}
@@ -0,0 +1,123 @@
+// Command apikey manages the API keys of the service:
+//
+//	apikey create [-name NAME] [-owner OWNER_ID]
+//	apikey revoke KEY_ID
+//
+// A key without -owner belongs to a new owner, a key with -owner is added to the existing one,
+// e.g. to rotate the keys. The database is configured by the POSTGRES_* environment variables.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/sethvargo/go-envconfig"
+
+	adapterPostgres "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/postgres"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	usecaseAuth "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth"
+	postgresClient "github.com/xgmsx/go-url-shortener-ddd/pkg/postgres"
+)
+
+const usage = `Usage:
+  apikey create [-name NAME] [-owner OWNER_ID]
+  apikey revoke KEY_ID
+`
+
+var errUsage = errors.New("invalid arguments")
+
+type config struct {
+	Postgres postgresClient.Config
+}
+
+func run(ctx context.Context, args []string, out io.Writer, km keyManager) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("create", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		name := fs.String("name", "", "key name, e.g. the client it is issued to")
+		owner := fs.String("owner", "", "owner id of an existing key, a new owner if empty")
+		if err := fs.Parse(args[1:]); err != nil || fs.NArg() > 0 {
+			return errUsage
+		}
+
+		input := dto.CreateAPIKeyInput{Name: *name}
+		if *owner != "" {
+			id, err := uuid.Parse(*owner)
+			if err != nil {
+				return fmt.Errorf("invalid owner id: %w", err)
+			}
+			input.OwnerID = id
+		}
+		if err := input.Validate(); err != nil {
+			return err
+		}
+
+		output, err := km.CreateKey(ctx, input)
+		if err != nil {
+			return fmt.Errorf("uc.CreateKey: %w", err)
+		}
+
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(output)
+	case "revoke":
+		if len(args) != 2 {
+			return errUsage
+		}
+
+		id, err := uuid.Parse(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid key id: %w", err)
+		}
+
+		err = km.RevokeKey(ctx, dto.RevokeAPIKeyInput{ID: id})
+		if err != nil {
+			return fmt.Errorf("uc.RevokeKey: %w", err)
+		}
+
+		_, err = fmt.Fprintf(out, "API key %s revoked\n", id)
+		return err
+	default:
+		return errUsage
+	}
+}
+
+func main() {
+	err := runWithPostgres(context.Background(), os.Args[1:])
+	if errors.Is(err, errUsage) {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func runWithPostgres(ctx context.Context, args []string) error {
+	var c config
+	if err := envconfig.Process(ctx, &c); err != nil {
+		return fmt.Errorf("envconfig.Process: %w", err)
+	}
+
+	postgres, err := postgresClient.New(ctx, &c.Postgres)
+	if err != nil {
+		return fmt.Errorf("postgres.New: %w", err)
+	}
+	defer postgres.Close()
+
+	uc := usecaseAuth.New(usecaseAuth.Config{}, adapterPostgres.New(postgres.Pool))
+
+	return run(ctx, args, os.Stdout, &uc)
+}
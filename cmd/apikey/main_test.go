@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mocks "github.com/xgmsx/go-url-shortener-ddd/cmd/apikey/mocks"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+)
+
+func TestRun(t *testing.T) {
+	keyID := uuid.MustParse("7a1c2f9e-0d3b-4f5a-9c8e-1b2d3e4f5a6b")
+	ownerID := uuid.MustParse("0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f")
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		args    []string
+		mock    func(km *mocks.MockkeyManager)
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "Create key of a new owner",
+			args: []string{"create", "-name", "ci"},
+			mock: func(km *mocks.MockkeyManager) {
+				km.EXPECT().CreateKey(gomock.Any(), dto.CreateAPIKeyInput{Name: "ci"}).
+					Return(dto.CreateAPIKeyOutput{ID: keyID, OwnerID: ownerID, Name: "ci", Key: "sk_test", CreatedAt: createdAt}, nil).Times(1)
+			},
+			wantOut: `{
+  "id": "7a1c2f9e-0d3b-4f5a-9c8e-1b2d3e4f5a6b",
+  "owner_id": "0f9e8d7c-6b5a-4c3d-8e2f-1a0b9c8d7e6f",
+  "name": "ci",
+  "key": "sk_test",
+  "created_at": "2026-10-18T12:00:00Z"
+}
+`,
+		},
+		{
+			name: "Create key of an existing owner",
+			args: []string{"create", "-owner", ownerID.String()},
+			mock: func(km *mocks.MockkeyManager) {
+				km.EXPECT().CreateKey(gomock.Any(), dto.CreateAPIKeyInput{OwnerID: ownerID}).Return(dto.CreateAPIKeyOutput{}, nil).Times(1)
+			},
+		},
+		{
+			name:    "Create key with invalid owner",
+			args:    []string{"create", "-owner", "owner"},
+			wantErr: "invalid owner id",
+		},
+		{
+			name: "Create key error",
+			args: []string{"create"},
+			mock: func(km *mocks.MockkeyManager) {
+				km.EXPECT().CreateKey(gomock.Any(), gomock.Any()).Return(dto.CreateAPIKeyOutput{}, errors.New("test db error")).Times(1)
+			},
+			wantErr: "test db error",
+		},
+		{
+			name: "Revoke key",
+			args: []string{"revoke", keyID.String()},
+			mock: func(km *mocks.MockkeyManager) {
+				km.EXPECT().RevokeKey(gomock.Any(), dto.RevokeAPIKeyInput{ID: keyID}).Return(nil).Times(1)
+			},
+			wantOut: "API key 7a1c2f9e-0d3b-4f5a-9c8e-1b2d3e4f5a6b revoked\n",
+		},
+		{
+			name:    "Revoke key with invalid id",
+			args:    []string{"revoke", "key"},
+			wantErr: "invalid key id",
+		},
+		{
+			name:    "Unknown command",
+			args:    []string{"list"},
+			wantErr: errUsage.Error(),
+		},
+		{
+			name:    "Unknown flag",
+			args:    []string{"create", "-force"},
+			wantErr: errUsage.Error(),
+		},
+		{
+			name:    "No command",
+			wantErr: errUsage.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			km := mocks.NewMockkeyManager(ctrl)
+			if tt.mock != nil {
+				tt.mock(km)
+			}
+
+			// act
+			var out bytes.Buffer
+			err := run(context.Background(), tt.args, &out, km)
+
+			// assert
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantOut != "" {
+				assert.Equal(t, tt.wantOut, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_main is a generated GoMock package.
+package mock_main
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	dto "github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+)
+
+// MockkeyManager is a mock of keyManager interface.
+type MockkeyManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockkeyManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockkeyManagerMockRecorder is the mock recorder for MockkeyManager.
+type MockkeyManagerMockRecorder struct {
+	mock *MockkeyManager
+}
+
+// NewMockkeyManager creates a new mock instance.
+func NewMockkeyManager(ctrl *gomock.Controller) *MockkeyManager {
+	mock := &MockkeyManager{ctrl: ctrl}
+	mock.recorder = &MockkeyManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkeyManager) EXPECT() *MockkeyManagerMockRecorder {
+	return m.recorder
+}
+
+// CreateKey mocks base method.
+func (m *MockkeyManager) CreateKey(ctx context.Context, input dto.CreateAPIKeyInput) (dto.CreateAPIKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, input)
+	ret0, _ := ret[0].(dto.CreateAPIKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockkeyManagerMockRecorder) CreateKey(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockkeyManager)(nil).CreateKey), ctx, input)
+}
+
+// RevokeKey mocks base method.
+func (m *MockkeyManager) RevokeKey(ctx context.Context, input dto.RevokeAPIKeyInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKey", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeKey indicates an expected call of RevokeKey.
+func (mr *MockkeyManagerMockRecorder) RevokeKey(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKey", reflect.TypeOf((*MockkeyManager)(nil).RevokeKey), ctx, input)
+}
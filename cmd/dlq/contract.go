@@ -0,0 +1,18 @@
+package main
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type messageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+type messageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
@@ -0,0 +1,123 @@
+// Command dlq manages the dead-letter topic of the kafka consumer:
+//
+//	dlq replay [-limit N] [-wait DURATION]
+//
+// replay moves the messages of the dead-letter topic back to the input topic without the dlq_* headers,
+// so the service handles them again. Only the messages dead-lettered before the start are replayed: a partition
+// is left at its first newer message, e.g. a replayed message failing again, until the next run. The replay stops
+// after -limit messages (0 - unlimited) or when no message to replay arrives for -wait. Replayed messages are
+// committed by a separate consumer group.
+// Kafka is configured by the KAFKA_* environment variables of the service.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/sethvargo/go-envconfig"
+
+	controllerKafka "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/kafka"
+	kafkaReader "github.com/xgmsx/go-url-shortener-ddd/pkg/kafka/reader"
+	kafkaWriter "github.com/xgmsx/go-url-shortener-ddd/pkg/kafka/writer"
+)
+
+const usage = `Usage:
+  dlq replay [-limit N] [-wait DURATION]
+`
+
+// replayGroup is appended to KAFKA_GROUP to get the consumer group of the dead-letter topic.
+const replayGroup = "-dlq-replay"
+
+var errUsage = errors.New("invalid arguments")
+
+type config struct {
+	Kafka kafkaReader.Config
+}
+
+func run(ctx context.Context, args []string, out io.Writer, r messageReader, w messageWriter) error {
+	if len(args) == 0 || args[0] != "replay" {
+		return errUsage
+	}
+
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	limit := fs.Int("limit", 0, "max messages to replay, 0 - unlimited")
+	wait := fs.Duration("wait", 10*time.Second, "stop when no message arrives for the duration")
+	if err := fs.Parse(args[1:]); err != nil || fs.NArg() > 0 || *limit < 0 || *wait <= 0 {
+		return errUsage
+	}
+
+	start := time.Now()
+	reached := make(map[int]bool) // partitions that reached the messages dead-lettered after the start
+
+	var replayed int
+	deadline := start.Add(*wait)
+	for *limit == 0 || replayed < *limit {
+		fetchCtx, cancel := context.WithDeadline(ctx, deadline)
+		m, err := r.FetchMessage(fetchCtx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			break // the topic is drained
+		}
+		if err != nil {
+			return fmt.Errorf("r.FetchMessage: %w", err)
+		}
+
+		// not committed, the partition is replayed from this message by the next run
+		if reached[m.Partition] || !m.Time.Before(start) {
+			reached[m.Partition] = true
+			continue
+		}
+
+		if err = w.WriteMessages(ctx, controllerKafka.ReplayMessage(m)); err != nil {
+			return fmt.Errorf("w.WriteMessages: %w", err)
+		}
+
+		if err = r.CommitMessages(ctx, m); err != nil {
+			return fmt.Errorf("r.CommitMessages: %w", err)
+		}
+		replayed++
+		deadline = time.Now().Add(*wait)
+	}
+
+	_, err := fmt.Fprintf(out, "%d messages replayed\n", replayed)
+	return err
+}
+
+func main() {
+	err := runWithKafka(context.Background(), os.Args[1:])
+	if errors.Is(err, errUsage) {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func runWithKafka(ctx context.Context, args []string) error {
+	var c config
+	if err := envconfig.Process(ctx, &c); err != nil {
+		return fmt.Errorf("envconfig.Process: %w", err)
+	}
+
+	r, err := kafkaReader.New(&kafkaReader.Config{Addr: c.Kafka.Addr, Topic: c.Kafka.DeadLetterTopic, Group: c.Kafka.Group + replayGroup})
+	if err != nil {
+		return fmt.Errorf("kafkaReader.New: %w", err)
+	}
+	defer r.Close()
+
+	w, err := kafkaWriter.New(&kafkaWriter.Config{Addr: c.Kafka.Addr, Topic: c.Kafka.Topic})
+	if err != nil {
+		return fmt.Errorf("kafkaWriter.New: %w", err)
+	}
+	defer w.Close()
+
+	return run(ctx, args, os.Stdout, r, w)
+}
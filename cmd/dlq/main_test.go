@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	mocks "github.com/xgmsx/go-url-shortener-ddd/cmd/dlq/mocks"
+	controllerKafka "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/kafka"
+)
+
+func TestRun(t *testing.T) {
+	command := kafka.Header{Key: controllerKafka.HeaderCommand, Value: []byte(controllerKafka.CommandDelete)}
+	deadLetter := kafka.Message{
+		Topic:  "links-requested-dlq",
+		Offset: 7,
+		Key:    []byte("alias1"),
+		Value:  []byte(`{"alias": "alias1"}`),
+		Headers: []kafka.Header{
+			command,
+			{Key: controllerKafka.HeaderDLQError, Value: []byte("test db error")},
+			{Key: controllerKafka.HeaderDLQAttempts, Value: []byte("5")},
+		},
+	}
+	replayed := kafka.Message{Key: []byte("alias1"), Value: []byte(`{"alias": "alias1"}`), Headers: []kafka.Header{command}}
+	failedAgain := deadLetter
+	failedAgain.Offset, failedAgain.Time = 8, time.Now().Add(time.Hour)
+	otherPartition := deadLetter
+	otherPartition.Partition = 1
+	drained := func(ctx context.Context) (kafka.Message, error) { <-ctx.Done(); return kafka.Message{}, ctx.Err() }
+
+	tests := []struct {
+		name    string
+		args    []string
+		mock    func(r *mocks.MockmessageReader, w *mocks.MockmessageWriter)
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "Replay until drained",
+			args: []string{"replay", "-wait", "10ms"},
+			mock: func(r *mocks.MockmessageReader, w *mocks.MockmessageWriter) {
+				gomock.InOrder(
+					r.EXPECT().FetchMessage(gomock.Any()).Return(deadLetter, nil).Times(2),
+					r.EXPECT().FetchMessage(gomock.Any()).DoAndReturn(drained).Times(1),
+				)
+				w.EXPECT().WriteMessages(gomock.Any(), replayed).Return(nil).Times(2)
+				r.EXPECT().CommitMessages(gomock.Any(), deadLetter).Return(nil).Times(2)
+			},
+			wantOut: "2 messages replayed\n",
+		},
+		{
+			name: "Messages dead-lettered after the start are left",
+			args: []string{"replay", "-wait", "10ms"},
+			mock: func(r *mocks.MockmessageReader, w *mocks.MockmessageWriter) {
+				gomock.InOrder(
+					r.EXPECT().FetchMessage(gomock.Any()).Return(failedAgain, nil).Times(1),
+					r.EXPECT().FetchMessage(gomock.Any()).Return(deadLetter, nil).Times(1),
+					r.EXPECT().FetchMessage(gomock.Any()).Return(otherPartition, nil).Times(1),
+					r.EXPECT().FetchMessage(gomock.Any()).DoAndReturn(drained).Times(1),
+				)
+				w.EXPECT().WriteMessages(gomock.Any(), replayed).Return(nil).Times(1)
+				r.EXPECT().CommitMessages(gomock.Any(), otherPartition).Return(nil).Times(1)
+			},
+			wantOut: "1 messages replayed\n",
+		},
+		{
+			name: "Replay with limit",
+			args: []string{"replay", "-limit", "1"},
+			mock: func(r *mocks.MockmessageReader, w *mocks.MockmessageWriter) {
+				r.EXPECT().FetchMessage(gomock.Any()).Return(deadLetter, nil).Times(1)
+				w.EXPECT().WriteMessages(gomock.Any(), replayed).Return(nil).Times(1)
+				r.EXPECT().CommitMessages(gomock.Any(), deadLetter).Return(nil).Times(1)
+			},
+			wantOut: "1 messages replayed\n",
+		},
+		{
+			name: "Empty topic",
+			args: []string{"replay", "-wait", "10ms"},
+			mock: func(r *mocks.MockmessageReader, _ *mocks.MockmessageWriter) {
+				r.EXPECT().FetchMessage(gomock.Any()).DoAndReturn(drained).Times(1)
+			},
+			wantOut: "0 messages replayed\n",
+		},
+		{
+			name: "Fetch error",
+			args: []string{"replay"},
+			mock: func(r *mocks.MockmessageReader, _ *mocks.MockmessageWriter) {
+				r.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, errors.New("test kafka error")).Times(1)
+			},
+			wantErr: "test kafka error",
+		},
+		{
+			name: "Write error is not committed",
+			args: []string{"replay"},
+			mock: func(r *mocks.MockmessageReader, w *mocks.MockmessageWriter) {
+				r.EXPECT().FetchMessage(gomock.Any()).Return(deadLetter, nil).Times(1)
+				w.EXPECT().WriteMessages(gomock.Any(), replayed).Return(errors.New("test kafka error")).Times(1)
+			},
+			wantErr: "test kafka error",
+		},
+		{
+			name:    "Negative limit",
+			args:    []string{"replay", "-limit", "-1"},
+			wantErr: errUsage.Error(),
+		},
+		{
+			name:    "Unknown command",
+			args:    []string{"purge"},
+			wantErr: errUsage.Error(),
+		},
+		{
+			name:    "No command",
+			wantErr: errUsage.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mocks.NewMockmessageReader(ctrl)
+			w := mocks.NewMockmessageWriter(ctrl)
+			if tt.mock != nil {
+				tt.mock(r, w)
+			}
+
+			// act
+			var out bytes.Buffer
+			err := run(context.Background(), tt.args, &out, r, w)
+
+			// assert
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantOut != "" {
+				assert.Equal(t, tt.wantOut, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_main is a generated GoMock package.
+package mock_main
+
+import (
+	context "context"
+	reflect "reflect"
+
+	kafka "github.com/segmentio/kafka-go"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockmessageReader is a mock of messageReader interface.
+type MockmessageReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockmessageReaderMockRecorder is the mock recorder for MockmessageReader.
+type MockmessageReaderMockRecorder struct {
+	mock *MockmessageReader
+}
+
+// NewMockmessageReader creates a new mock instance.
+func NewMockmessageReader(ctrl *gomock.Controller) *MockmessageReader {
+	mock := &MockmessageReader{ctrl: ctrl}
+	mock.recorder = &MockmessageReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageReader) EXPECT() *MockmessageReaderMockRecorder {
+	return m.recorder
+}
+
+// CommitMessages mocks base method.
+func (m *MockmessageReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range msgs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitMessages", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitMessages indicates an expected call of CommitMessages.
+func (mr *MockmessageReaderMockRecorder) CommitMessages(ctx any, msgs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, msgs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitMessages", reflect.TypeOf((*MockmessageReader)(nil).CommitMessages), varargs...)
+}
+
+// FetchMessage mocks base method.
+func (m *MockmessageReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchMessage", ctx)
+	ret0, _ := ret[0].(kafka.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMessage indicates an expected call of FetchMessage.
+func (mr *MockmessageReaderMockRecorder) FetchMessage(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMessage", reflect.TypeOf((*MockmessageReader)(nil).FetchMessage), ctx)
+}
+
+// MockmessageWriter is a mock of messageWriter interface.
+type MockmessageWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockmessageWriterMockRecorder is the mock recorder for MockmessageWriter.
+type MockmessageWriterMockRecorder struct {
+	mock *MockmessageWriter
+}
+
+// NewMockmessageWriter creates a new mock instance.
+func NewMockmessageWriter(ctrl *gomock.Controller) *MockmessageWriter {
+	mock := &MockmessageWriter{ctrl: ctrl}
+	mock.recorder = &MockmessageWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageWriter) EXPECT() *MockmessageWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteMessages mocks base method.
+func (m *MockmessageWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range msgs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WriteMessages", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteMessages indicates an expected call of WriteMessages.
+func (mr *MockmessageWriterMockRecorder) WriteMessages(ctx any, msgs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, msgs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteMessages", reflect.TypeOf((*MockmessageWriter)(nil).WriteMessages), varargs...)
+}
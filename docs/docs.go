@@ -17,6 +17,11 @@ const docTemplate = `{
     "paths": {
         "/shortener/v1/link": {
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -57,12 +62,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.ErrHTTP"
                         }
                     },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/http.ErrHTTP"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "422": {
+                        "description": "the url matches the blocklist",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -74,6 +97,11 @@ const docTemplate = `{
         },
         "/shortener/v1/link/{alias}": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "consumes": [
                     "text/plain"
                 ],
@@ -106,12 +134,206 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.ErrHTTP"
                         }
                     },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Links"
+                ],
+                "summary": "Delete a short link by alias",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link alias",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "link deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Links"
+                ],
+                "summary": "Update the URL and/or expiration of a short link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link alias",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Changed fields",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateLinkInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateLinkOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/http.ErrHTTP"
                         }
                     },
+                    "422": {
+                        "description": "the url matches the blocklist",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortener/v1/link/{alias}/available": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Links"
+                ],
+                "summary": "Check whether an alias is available for a new link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link alias",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CheckAliasOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -143,9 +365,21 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "the password prompt of a protected link"
+                    },
+                    "301": {
+                        "description": "permanent redirect to the original url"
+                    },
                     "302": {
                         "description": "redirect to the original url"
                     },
+                    "307": {
+                        "description": "temporary redirect to the original url"
+                    },
+                    "308": {
+                        "description": "permanent redirect to the original url"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -158,6 +392,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.ErrHTTP"
                         }
                     },
+                    "410": {
+                        "description": "the link is expired, blocked or has no clicks left",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -165,29 +405,525 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "dto.CreateLinkInput": {
-            "type": "object",
-            "properties": {
-                "url": {
-                    "type": "string"
+            },
+            "post": {
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Links"
+                ],
+                "summary": "Open a password-protected link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link alias",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link password",
+                        "name": "password",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "303": {
+                        "description": "redirect to the original url"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "the password prompt, the password is wrong"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "410": {
+                        "description": "the link is expired, blocked or has no clicks left",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "429": {
+                        "description": "the password prompt, too many wrong passwords"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
                 }
             }
         },
-        "dto.CreateLinkOutput": {
-            "type": "object",
-            "properties": {
-                "alias": {
-                    "type": "string"
-                },
-                "expired_at": {
-                    "type": "string"
-                },
-                "url": {
+        "/shortener/v1/link/{alias}/stats": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get click statistics of a short link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link alias",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Size of the breakdowns (1-100, default 10)",
+                        "name": "top",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LinkStatsOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortener/v1/link/{alias}/stats/timeseries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get a click histogram of a short link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Link alias",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the range, inclusive (RFC 3339)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range, exclusive (RFC 3339)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day",
+                            "week"
+                        ],
+                        "type": "string",
+                        "description": "Bucket size",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone of the buckets, default UTC",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LinkTimeseriesOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortener/v1/links": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Links"
+                ],
+                "summary": "List short links page by page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Owner of the links, ignored for API keys which list their own links",
+                        "name": "owner_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC 3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "expired"
+                        ],
+                        "type": "string",
+                        "description": "Expiry state",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Substring of the target domain",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "expired_at"
+                        ],
+                        "type": "string",
+                        "description": "Sort key, default created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, default desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Size of the page (1-100, default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListLinksOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortener/v1/links:batch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The result of each link is reported in the order of the input, invalid links and taken aliases\ndon't fail the whole batch.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Links"
+                ],
+                "summary": "Create up to 1000 short links at once",
+                "parameters": [
+                    {
+                        "description": "New links",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateLinksInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateLinksOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "401": {
+                        "description": "missing or invalid api key",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrHTTP"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.CheckAliasOutput": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "available": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.CreateLinkInput": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "expired_at": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "description": "seconds",
+                    "type": "integer"
+                },
+                "forward_query": {
+                    "description": "merge the query string of the redirect request into the URL",
+                    "type": "boolean"
+                },
+                "max_clicks": {
+                    "description": "redirects before the link stops working, 1 - one-time link",
+                    "type": "integer"
+                },
+                "never_expires": {
+                    "type": "boolean"
+                },
+                "password": {
+                    "description": "visitors must enter it before the redirect",
+                    "type": "string"
+                },
+                "redirect_code": {
+                    "description": "301, 302, 307 or 308, LINK_REDIRECT_CODE if not set",
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateLinkOutput": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "expired_at": {
+                    "type": "string"
+                },
+                "forward_query": {
+                    "type": "boolean"
+                },
+                "max_clicks": {
+                    "description": "0 - unlimited",
+                    "type": "integer"
+                },
+                "password_protected": {
+                    "description": "PasswordProtected links open after the visitor enters the password",
+                    "type": "boolean"
+                },
+                "redirect_code": {
+                    "type": "integer"
+                },
+                "short_url": {
                     "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateLinksInput": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CreateLinkInput"
+                    }
+                }
+            }
+        },
+        "dto.CreateLinksItem": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "existing": {
+                    "description": "Existing is set in dedup mode when the link of the same URL is returned instead of a new one",
+                    "type": "boolean"
+                },
+                "index": {
+                    "description": "position of the link in the input",
+                    "type": "integer"
+                },
+                "link": {
+                    "$ref": "#/definitions/dto.CreateLinkOutput"
+                }
+            }
+        },
+        "dto.CreateLinksOutput": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "description": "the existing links returned in dedup mode are not counted",
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CreateLinksItem"
+                    }
                 }
             }
         },
@@ -200,6 +936,224 @@ const docTemplate = `{
                 "expired_at": {
                     "type": "string"
                 },
+                "forward_query": {
+                    "type": "boolean"
+                },
+                "max_clicks": {
+                    "description": "0 - unlimited",
+                    "type": "integer"
+                },
+                "password_protected": {
+                    "description": "PasswordProtected links open after the visitor enters the password",
+                    "type": "boolean"
+                },
+                "redirect_code": {
+                    "type": "integer"
+                },
+                "short_url": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LinkStatsOutput": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "browsers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StatsEntry"
+                    }
+                },
+                "clicks_day": {
+                    "description": "last 24 hours",
+                    "type": "integer"
+                },
+                "clicks_month": {
+                    "description": "last 30 days",
+                    "type": "integer"
+                },
+                "clicks_week": {
+                    "description": "last 7 days",
+                    "type": "integer"
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StatsEntry"
+                    }
+                },
+                "devices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StatsEntry"
+                    }
+                },
+                "first_click_at": {
+                    "type": "string"
+                },
+                "last_click_at": {
+                    "type": "string"
+                },
+                "os": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StatsEntry"
+                    }
+                },
+                "referrers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.StatsEntry"
+                    }
+                },
+                "total_clicks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LinkTimeseriesOutput": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TimeseriesBucket"
+                    }
+                },
+                "interval": {
+                    "type": "string"
+                },
+                "tz": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ListLinksItem": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expired_at": {
+                    "type": "string"
+                },
+                "forward_query": {
+                    "type": "boolean"
+                },
+                "max_clicks": {
+                    "description": "0 - unlimited",
+                    "type": "integer"
+                },
+                "password_protected": {
+                    "description": "PasswordProtected links open after the visitor enters the password",
+                    "type": "boolean"
+                },
+                "redirect_code": {
+                    "type": "integer"
+                },
+                "short_url": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ListLinksOutput": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ListLinksItem"
+                    }
+                },
+                "next_cursor": {
+                    "description": "empty on the last page",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StatsEntry": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer"
+                },
+                "value": {
+                    "description": "empty if unknown",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TimeseriesBucket": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer"
+                },
+                "start": {
+                    "description": "in the requested time zone",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateLinkInput": {
+            "type": "object",
+            "properties": {
+                "expired_at": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "description": "seconds",
+                    "type": "integer"
+                },
+                "never_expires": {
+                    "type": "boolean"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateLinkOutput": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "expired_at": {
+                    "type": "string"
+                },
+                "forward_query": {
+                    "type": "boolean"
+                },
+                "max_clicks": {
+                    "description": "0 - unlimited",
+                    "type": "integer"
+                },
+                "password_protected": {
+                    "description": "PasswordProtected links open after the visitor enters the password",
+                    "type": "boolean"
+                },
+                "redirect_code": {
+                    "type": "integer"
+                },
+                "short_url": {
+                    "type": "string"
+                },
                 "url": {
                     "type": "string"
                 }
@@ -213,6 +1167,13 @@ const docTemplate = `{
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        }
     }
 }`
 
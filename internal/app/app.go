@@ -6,22 +6,44 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog/log"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/config"
+	adapterAlias "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/alias"
+	adapterBlocklist "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/blocklist"
+	adapterGeoIP "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/geoip"
 	adapterKafka "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/kafka"
 	adapterPostgres "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/postgres"
 	adapterRedis "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/redis"
+	adapterShortURL "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/shorturl"
+	adapterUserAgent "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/useragent"
+	controllerBlocker "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/blocker"
+	controllerClicks "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/clicks"
 	controllerGRPC "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/grpc"
 	controllerHTTP "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/http"
 	controllerKafka "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/kafka"
+	controllerReaper "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/reaper"
+	controllerRelay "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/relay"
+	controllerRollup "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/rollup"
+	usecaseAuth "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth"
+	usecaseBlock "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/block"
 	usecaseCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	usecaseFetch "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	usecaseList "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	usecaseReap "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/reap"
+	usecaseRelay "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/relay"
+	usecaseDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	usecaseStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	usecaseUpdate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/grpc"
+	interceptorRateLimit "github.com/xgmsx/go-url-shortener-ddd/pkg/grpc/interceptors/ratelimit"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/http"
+	middlewareRateLimit "github.com/xgmsx/go-url-shortener-ddd/pkg/http/middlewares/ratelimit"
 	kafkaReader "github.com/xgmsx/go-url-shortener-ddd/pkg/kafka/reader"
 	kafkaWriter "github.com/xgmsx/go-url-shortener-ddd/pkg/kafka/writer"
 	postgresClient "github.com/xgmsx/go-url-shortener-ddd/pkg/postgres"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
 	redisClient "github.com/xgmsx/go-url-shortener-ddd/pkg/redis"
 )
 
@@ -57,30 +79,114 @@ func (a App) Run(ctx context.Context, c *config.Config) error {
 	}
 	defer KafkaReader.Close()
 
+	DeadLetterWriter, err := kafkaWriter.New(&kafkaWriter.Config{Addr: c.KafkaReader.Addr, Topic: c.KafkaReader.DeadLetterTopic})
+	if err != nil {
+		return fmt.Errorf("kafkaWriter.New: %w", err)
+	}
+	defer DeadLetterWriter.Close()
+
 	// init adapter
 	database := adapterPostgres.New(postgres.Pool)
 	cache := adapterRedis.New(redis.Client)
-	publisher := adapterKafka.New(KafkaWriter.Writer)
+
+	publisher, err := adapterKafka.New(c.KafkaEvents, KafkaWriter.Writer)
+	if err != nil {
+		return fmt.Errorf("kafka.New: %w", err)
+	}
+
+	aliasGenerator, err := adapterAlias.New(c.Alias, database)
+	if err != nil {
+		return fmt.Errorf("alias.New: %w", err)
+	}
+
+	geoLocator, err := adapterGeoIP.New(c.GeoIP)
+	if err != nil {
+		return fmt.Errorf("geoip.New: %w", err)
+	}
+	defer geoLocator.Close()
+
+	userAgentParser := adapterUserAgent.New()
+
+	shortURLBuilder, err := adapterShortURL.New(c.ShortURL)
+	if err != nil {
+		return fmt.Errorf("shorturl.New: %w", err)
+	}
+
+	blocklist, err := adapterBlocklist.New(c.Blocklist)
+	if err != nil {
+		return fmt.Errorf("blocklist.New: %w", err)
+	}
 
 	// init usecase
-	ucCreateLink := usecaseCreate.New(database, cache, publisher)
-	ucFetchLink := usecaseFetch.New(database, cache)
+	ucCreateLink := usecaseCreate.New(c.CreateLink, database, cache, aliasGenerator, shortURLBuilder, blocklist)
+	ucFetchLink := usecaseFetch.New(c.FetchLink, database, cache, shortURLBuilder)
+	ucUpdateLink := usecaseUpdate.New(c.CreateLink.Lifetime(), database, cache, shortURLBuilder, blocklist)
+	ucDeleteLink := usecaseDelete.New(database, cache)
+	ucListLinks := usecaseList.New(database, shortURLBuilder)
+	ucReapLinks := usecaseReap.New(database, cache)
+	ucRelayEvents := usecaseRelay.New(c.Outbox, database, publisher)
+	ucLinkStats := usecaseStats.New(database, geoLocator, userAgentParser)
+	ucBlockLinks := usecaseBlock.New(database, cache, blocklist)
+	ucAuth := usecaseAuth.New(c.Auth, database)
 
 	// init controller
 	errCh := make(chan error)
 	defer close(errCh)
 
-	httpServer := http.New(c.HTTP, nil, controllerHTTP.New("/api/shortener", ucCreateLink, ucFetchLink))
+	// the recorder is closed after the servers to save the clicks of in-flight redirects
+	clickRecorder := controllerClicks.New(c.Clicks, ucLinkStats)
+	clickRecorder.Start(ctx)
+	defer clickRecorder.Close()
+
+	// the limits are shared by the HTTP and gRPC servers of all instances
+	rateLimiter := ratelimit.New(redis.Client)
+	var httpRateLimit fiber.Handler
+	if c.RateLimit.Enabled {
+		httpRateLimit = middlewareRateLimit.New(middlewareRateLimit.Config{
+			Limiter: rateLimiter, Limits: c.RateLimit.Rules, KeyGenerator: controllerHTTP.RateLimitKey,
+		})
+	}
+
+	httpServer := http.New(c.HTTP, nil, controllerHTTP.New(
+		"/api/shortener", ucCreateLink, ucFetchLink, ucUpdateLink, ucDeleteLink, ucListLinks, ucLinkStats, ucAuth, clickRecorder,
+		httpRateLimit,
+	))
 	go func() { errCh <- httpServer.Serve(c.HTTP.Port) }()
 	defer httpServer.Close()
 
-	grpcServer := grpc.New(controllerGRPC.New(ucCreateLink, ucFetchLink))
+	grpcController := controllerGRPC.New(
+		ucCreateLink, ucFetchLink, ucUpdateLink, ucDeleteLink, ucListLinks, ucLinkStats, ucAuth,
+	)
+	grpcInterceptors := grpcController.UnaryInterceptors()
+	if c.RateLimit.Enabled {
+		// the calls are counted per verified API key, so the limits follow the authentication
+		grpcInterceptors = append(grpcInterceptors,
+			interceptorRateLimit.UnaryServerInterceptor(rateLimiter, c.RateLimit.Rules, controllerGRPC.RateLimitKey),
+		)
+	}
+	grpcServer := grpc.New(&grpc.Options{UnaryInterceptors: grpcInterceptors}, grpcController)
 	go func() { errCh <- grpcServer.Serve(ctx, c.GRPC.Port) }()
 	defer grpcServer.Close()
 
-	kafkaConsumer := controllerKafka.New(KafkaReader, ucCreateLink)
+	kafkaConsumer := controllerKafka.New(c.KafkaConsumer, KafkaReader, DeadLetterWriter, ucCreateLink, ucDeleteLink)
 	go func() { errCh <- kafkaConsumer.Consume(ctx) }()
 
+	reaper := controllerReaper.New(c.Reaper, ucReapLinks)
+	reaper.Start(ctx)
+	defer reaper.Close()
+
+	outboxRelay := controllerRelay.New(c.OutboxRelay, ucRelayEvents)
+	outboxRelay.Start(ctx)
+	defer outboxRelay.Close()
+
+	clicksRollup := controllerRollup.New(c.ClicksRollup, ucLinkStats)
+	clicksRollup.Start(ctx)
+	defer clicksRollup.Close()
+
+	blocker := controllerBlocker.New(c.Blocker, blocklist, ucBlockLinks)
+	blocker.Start(ctx)
+	defer blocker.Close()
+
 	return a.waiting(errCh)
 }
 
@@ -5,6 +5,21 @@ import (
 
 	"github.com/sethvargo/go-envconfig"
 
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/alias"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/blocklist"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/geoip"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/kafka"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/shorturl"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/blocker"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/clicks"
+	controllerKafka "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/kafka"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/reaper"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/relay"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/rollup"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	usecaseRelay "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/relay"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/grpc"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/http"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/kafka/reader"
@@ -13,6 +28,7 @@ import (
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/sentry"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/postgres"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/redis"
 )
 
@@ -34,8 +50,25 @@ type Config struct {
 	KafkaWriter writer.Config
 	KafkaReader reader.Config
 	// Controllers
-	HTTP http.Config
-	GRPC grpc.Config
+	HTTP          http.Config
+	GRPC          grpc.Config
+	RateLimit     ratelimit.Config
+	KafkaConsumer controllerKafka.Config
+	Reaper        reaper.Config
+	OutboxRelay   relay.Config
+	Clicks        clicks.Config
+	ClicksRollup  rollup.Config
+	Blocker       blocker.Config
+	// Usecases
+	CreateLink  create.Config
+	FetchLink   fetch.Config
+	Outbox      usecaseRelay.Config
+	Auth        auth.Config
+	Alias       alias.Config
+	GeoIP       geoip.Config
+	ShortURL    shorturl.Config
+	Blocklist   blocklist.Config
+	KafkaEvents kafka.Config
 }
 
 func New() *Config {
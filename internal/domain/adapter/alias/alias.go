@@ -0,0 +1,46 @@
+package alias
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+const (
+	StrategyUUID     = "uuid"
+	StrategyRandom   = "random"
+	StrategySequence = "sequence"
+
+	minLength = 2
+	maxLength = 64
+)
+
+var alphabetPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+type Config struct {
+	Strategy string `env:"ALIAS_STRATEGY, default=uuid"` // uuid, random or sequence
+	Length   int    `env:"ALIAS_LENGTH, default=7"`      // random only
+	Alphabet string `env:"ALIAS_ALPHABET, default=23456789abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"`
+}
+
+type Generator interface {
+	Generate(ctx context.Context) (string, error)
+}
+
+type sequence interface {
+	NextAliasID(ctx context.Context) (int64, error)
+}
+
+// New returns the alias generator selected by c.Strategy.
+func New(c Config, seq sequence) (Generator, error) {
+	switch c.Strategy {
+	case StrategyUUID:
+		return NewUUID(), nil
+	case StrategyRandom:
+		return NewRandom(c.Length, c.Alphabet)
+	case StrategySequence:
+		return NewSequence(seq), nil
+	default:
+		return nil, fmt.Errorf("unknown alias strategy: %q", c.Strategy)
+	}
+}
@@ -0,0 +1,100 @@
+package alias
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sequenceFunc func(ctx context.Context) (int64, error)
+
+func (f sequenceFunc) NextAliasID(ctx context.Context) (int64, error) { return f(ctx) }
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  Config
+		want    Generator
+		wantErr string
+	}{
+		{name: "UUID", config: Config{Strategy: StrategyUUID}, want: &UUID{}},
+		{name: "Random", config: Config{Strategy: StrategyRandom, Length: 7, Alphabet: "abc"}, want: &Random{}},
+		{name: "Sequence", config: Config{Strategy: StrategySequence}, want: &Sequence{}},
+		{name: "Random with short length", config: Config{Strategy: StrategyRandom, Length: 1, Alphabet: "abc"}, wantErr: "alias length"},
+		{name: "Random with invalid alphabet", config: Config{Strategy: StrategyRandom, Length: 7, Alphabet: "a/b"}, wantErr: "invalid alias alphabet"},
+		{name: "Unknown strategy", config: Config{Strategy: "md5"}, wantErr: "unknown alias strategy"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := New(tc.config, nil)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, g)
+		})
+	}
+}
+
+func TestUUID(t *testing.T) {
+	alias, err := NewUUID().Generate(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, alias, 22)
+}
+
+func TestRandom(t *testing.T) {
+	const alphabet = "23456789abc"
+
+	g, err := NewRandom(7, alphabet)
+	require.NoError(t, err)
+
+	for range 100 {
+		alias, err := g.Generate(context.Background())
+		require.NoError(t, err)
+		require.Len(t, alias, 7)
+		for _, r := range alias {
+			assert.True(t, strings.ContainsRune(alphabet, r), "unexpected character %q", r)
+		}
+	}
+}
+
+func TestSequence(t *testing.T) {
+	testCases := []struct {
+		name    string
+		id      int64
+		err     error
+		want    string
+		wantErr string
+	}{
+		{name: "Zero", id: 0, want: "0"},
+		{name: "One digit", id: 61, want: "Z"},
+		{name: "Two digits", id: 62, want: "10"},
+		{name: "First 5-character alias", id: 14776336, want: "10000"},
+		{name: "Negative value", id: -1, wantErr: "invalid sequence value"},
+		{name: "Database error", err: errors.New("test db error"), wantErr: "test db error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seq := sequenceFunc(func(context.Context) (int64, error) { return tc.id, tc.err })
+
+			alias, err := NewSequence(seq).Generate(context.Background())
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, alias)
+		})
+	}
+}
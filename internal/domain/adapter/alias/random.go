@@ -0,0 +1,45 @@
+package alias
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+// Random generates aliases of a fixed length from the given alphabet.
+// Collisions are possible, so the caller must retry on a unique constraint violation.
+type Random struct {
+	length   int
+	alphabet []byte
+	max      *big.Int
+}
+
+func NewRandom(length int, alphabet string) (*Random, error) {
+	if length < minLength || length > maxLength {
+		return nil, fmt.Errorf("alias length must be between %d and %d", minLength, maxLength)
+	}
+
+	if len(alphabet) < minLength || !alphabetPattern.MatchString(alphabet) {
+		return nil, fmt.Errorf("invalid alias alphabet: %q", alphabet)
+	}
+
+	return &Random{
+		length:   length,
+		alphabet: []byte(alphabet),
+		max:      big.NewInt(int64(len(alphabet))),
+	}, nil
+}
+
+func (g *Random) Generate(_ context.Context) (string, error) {
+	alias := make([]byte, g.length)
+	for i := range alias {
+		n, err := rand.Int(rand.Reader, g.max)
+		if err != nil {
+			return "", fmt.Errorf("rand.Int: %w", err)
+		}
+		alias[i] = g.alphabet[n.Int64()]
+	}
+
+	return string(alias), nil
+}
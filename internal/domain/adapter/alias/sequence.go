@@ -0,0 +1,47 @@
+package alias
+
+import (
+	"context"
+	"fmt"
+)
+
+const base62 = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// Sequence generates short base62 aliases from a database sequence,
+// which keeps them unique across all running instances.
+type Sequence struct {
+	seq sequence
+}
+
+func NewSequence(seq sequence) *Sequence {
+	return &Sequence{seq: seq}
+}
+
+func (g *Sequence) Generate(ctx context.Context) (string, error) {
+	id, err := g.seq.NextAliasID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("g.seq.NextAliasID: %w", err)
+	}
+
+	if id < 0 {
+		return "", fmt.Errorf("invalid sequence value: %d", id)
+	}
+
+	return encodeBase62(uint64(id)), nil
+}
+
+func encodeBase62(n uint64) string {
+	if n == 0 {
+		return base62[:1]
+	}
+
+	var buf [11]byte // max uint64 is 11 base62 digits
+	i := len(buf)
+	for n > 0 {
+		i--
+		buf[i] = base62[n%62]
+		n /= 62
+	}
+
+	return string(buf[i:])
+}
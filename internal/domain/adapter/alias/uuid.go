@@ -0,0 +1,20 @@
+package alias
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/google/uuid"
+)
+
+// UUID generates 22-character aliases from random UUIDs.
+type UUID struct{}
+
+func NewUUID() *UUID {
+	return &UUID{}
+}
+
+func (g *UUID) Generate(_ context.Context) (string, error) {
+	id := uuid.New()
+	return base64.RawURLEncoding.EncodeToString(id[:]), nil
+}
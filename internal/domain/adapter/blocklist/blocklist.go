@@ -0,0 +1,156 @@
+package blocklist
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/idna"
+)
+
+const patternPrefix = "re:"
+
+type Config struct {
+	// File lists blocked domains, one per line. A domain blocks its subdomains too,
+	// lines starting with "re:" are regular expressions matched against the whole URL,
+	// lines starting with "#" are comments. The blocklist is disabled if empty.
+	File string `env:"BLOCKLIST_FILE"`
+}
+
+type rules struct {
+	domains  map[string]struct{}
+	patterns []*regexp.Regexp
+}
+
+// Blocklist matches URLs against the rules of the file, Reload picks up the file changes.
+type Blocklist struct {
+	file  string
+	rules atomic.Pointer[rules]
+
+	mu       sync.Mutex
+	checksum [sha256.Size]byte
+}
+
+func New(c Config) (*Blocklist, error) {
+	b := &Blocklist{file: c.File}
+	b.rules.Store(&rules{})
+
+	if c.File == "" {
+		log.Info().Msg("Blocklist disabled")
+		return b, nil
+	}
+
+	if _, err := b.Reload(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Enabled reports whether the blocklist is loaded from a file.
+func (b *Blocklist) Enabled() bool {
+	return b.file != ""
+}
+
+// Reload reads the file and reports whether the rules have changed.
+// The current rules are kept if the file can't be read or parsed.
+func (b *Blocklist) Reload() (bool, error) {
+	if !b.Enabled() {
+		return false, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	data, err := os.ReadFile(b.file)
+	if err != nil {
+		return false, fmt.Errorf("os.ReadFile: %w", err)
+	}
+
+	checksum := sha256.Sum256(data)
+	if checksum == b.checksum {
+		return false, nil
+	}
+
+	r, err := parse(data)
+	if err != nil {
+		return false, fmt.Errorf("blocklist.parse: %w", err)
+	}
+
+	b.rules.Store(r)
+	b.checksum = checksum
+
+	log.Info().Int("domains", len(r.domains)).Int("patterns", len(r.patterns)).Msg("Blocklist loaded")
+
+	return true, nil
+}
+
+// IsBlocked reports whether the URL, its domain or a parent domain is blocked.
+func (b *Blocklist) IsBlocked(rawURL string) bool {
+	r := b.rules.Load()
+
+	for _, p := range r.patterns {
+		if p.MatchString(rawURL) {
+			return true
+		}
+	}
+
+	if len(r.domains) == 0 {
+		return false
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	for host != "" {
+		if _, ok := r.domains[host]; ok {
+			return true
+		}
+		_, host, _ = strings.Cut(host, ".")
+	}
+
+	return false
+}
+
+func parse(data []byte) (*rules, error) {
+	r := &rules{domains: map[string]struct{}{}}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, patternPrefix):
+			p, err := regexp.Compile(strings.TrimPrefix(line, patternPrefix))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			r.patterns = append(r.patterns, p)
+		default:
+			domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(line, "."))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			r.domains[domain] = struct{}{}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanner.Err: %w", err)
+	}
+
+	return r, nil
+}
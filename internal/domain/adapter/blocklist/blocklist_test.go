@@ -0,0 +1,79 @@
+package blocklist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBlocked(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "blocklist.txt")
+	rules := "# phishing\nevil.com\nПример.РФ.\n\nre:^https?://[^/]+/wp-admin/\n"
+	require.NoError(t, os.WriteFile(file, []byte(rules), 0o600))
+
+	b, err := New(Config{File: file})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		url  string
+		want bool
+	}{
+		{url: "https://evil.com", want: true},
+		{url: "https://login.evil.com:8443/account", want: true},
+		{url: "http://EVIL.com./", want: true},
+		{url: "http://xn--e1afmkfd.xn--p1ai/", want: true},
+		{url: "https://example.com/wp-admin/login.php", want: true},
+		{url: "https://notevil.com", want: false},
+		{url: "https://evil.com.example.org", want: false},
+		{url: "https://example.com/blog/wp-admin/", want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			assert.Equal(t, tc.want, b.IsBlocked(tc.url))
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "blocklist.txt")
+	require.NoError(t, os.WriteFile(file, []byte("evil.com\n"), 0o600))
+
+	b, err := New(Config{File: file})
+	require.NoError(t, err)
+	assert.True(t, b.Enabled())
+
+	changed, err := b.Reload()
+	require.NoError(t, err)
+	assert.False(t, changed, "the file is not changed")
+
+	require.NoError(t, os.WriteFile(file, []byte("evil.org\n"), 0o600))
+	changed, err = b.Reload()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.False(t, b.IsBlocked("https://evil.com"))
+	assert.True(t, b.IsBlocked("https://evil.org"))
+
+	require.NoError(t, os.WriteFile(file, []byte("re:(unclosed\n"), 0o600))
+	changed, err = b.Reload()
+	assert.ErrorContains(t, err, "line 1")
+	assert.False(t, changed)
+	assert.True(t, b.IsBlocked("https://evil.org"), "the rules are kept after an invalid file")
+}
+
+func TestNew(t *testing.T) {
+	b, err := New(Config{})
+	require.NoError(t, err)
+	assert.False(t, b.Enabled())
+	assert.False(t, b.IsBlocked("https://evil.com"))
+
+	changed, err := b.Reload()
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, err = New(Config{File: "testdata/unknown.txt"})
+	assert.ErrorContains(t, err, "os.ReadFile")
+}
@@ -0,0 +1,62 @@
+package geoip
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/oschwald/geoip2-golang"
+	"github.com/rs/zerolog/log"
+)
+
+type Config struct {
+	// DatabasePath is a local MaxMind-format (GeoIP2/GeoLite2 Country or City) database file,
+	// the lookup is disabled if empty.
+	DatabasePath string `env:"GEOIP_DATABASE_PATH"`
+}
+
+type GeoIP struct {
+	reader *geoip2.Reader
+}
+
+func New(c Config) (*GeoIP, error) {
+	if c.DatabasePath == "" {
+		log.Info().Msg("GeoIP disabled")
+		return &GeoIP{}, nil
+	}
+
+	reader, err := geoip2.Open(c.DatabasePath)
+	if err != nil {
+		return nil, fmt.Errorf("geoip2.Open: %w", err)
+	}
+
+	return &GeoIP{reader: reader}, nil
+}
+
+// Country returns the ISO country code of the IP address, empty if it is unknown.
+func (g *GeoIP) Country(ip string) string {
+	addr := net.ParseIP(ip)
+	if g.reader == nil || addr == nil {
+		return ""
+	}
+
+	country, err := g.reader.Country(addr)
+	if err != nil {
+		log.Error().Err(err).Msg("g.reader.Country")
+		return ""
+	}
+
+	return country.Country.IsoCode
+}
+
+func (g *GeoIP) Close() {
+	if g.reader == nil {
+		return
+	}
+
+	err := g.reader.Close()
+	if err != nil {
+		log.Error().Err(err).Msg("geoip - g.reader.Close")
+	}
+
+	log.Info().Msg("GeoIP closed")
+}
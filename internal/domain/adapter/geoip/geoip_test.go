@@ -0,0 +1,19 @@
+package geoip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	g, err := New(Config{})
+	require.NoError(t, err)
+	defer g.Close()
+
+	assert.Empty(t, g.Country("8.8.8.8"))
+
+	_, err = New(Config{DatabasePath: "testdata/unknown.mmdb"})
+	assert.ErrorContains(t, err, "geoip2.Open")
+}
@@ -3,32 +3,150 @@ package kafka
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+	pb "github.com/xgmsx/go-url-shortener-ddd/proto/gen/link_events.v1"
 )
 
+// Message headers describing the event, the value is the encoded message of proto/link_events_v1.proto.
+const (
+	HeaderEventType   = "event_type"   // full name of the message, e.g. link_events_v1.LinkCreated
+	HeaderEventID     = "event_id"     // UUID, the same for redeliveries of the event
+	HeaderEventTime   = "event_time"   // RFC 3339 time of the change
+	HeaderContentType = "content_type" // encoding of the value
+)
+
+const (
+	FormatProtobuf = "protobuf"
+	FormatJSON     = "json"
+)
+
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+type Config struct {
+	Format string `env:"KAFKA_EVENTS_FORMAT, default=protobuf"` // encoding of the events, protobuf or json
+}
+
 type Producer struct {
-	writer *kafka.Writer
+	writer      *kafka.Writer
+	contentType string
+	marshal     func(proto.Message) ([]byte, error)
 }
 
-func New(writer *kafka.Writer) *Producer {
-	return &Producer{writer: writer}
+func New(c Config, writer *kafka.Writer) (*Producer, error) {
+	p := &Producer{writer: writer}
+
+	switch c.Format {
+	case FormatProtobuf:
+		p.contentType, p.marshal = ContentTypeProtobuf, proto.Marshal
+	case FormatJSON:
+		p.contentType, p.marshal = ContentTypeJSON, protojson.MarshalOptions{UseProtoNames: true}.Marshal
+	default:
+		return nil, fmt.Errorf("unknown events format: %q", c.Format)
+	}
+
+	return p, nil
 }
 
-func (p *Producer) SendLink(ctx context.Context, l entity.Link) error {
-	ctx, span := tracer.Start(ctx, "kafka SendLink")
+// SendLinkEvents publishes the events in one batch.
+func (p *Producer) SendLinkEvents(ctx context.Context, events ...entity.LinkEvent) error {
+	ctx, span := tracer.Start(ctx, "kafka SendLinkEvents")
 	defer span.End()
 
-	err := p.writer.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(l.Alias),
-		Value: []byte(l.URL),
-	})
+	if len(events) == 0 {
+		return nil
+	}
+
+	msgs := make([]kafka.Message, 0, len(events))
+	for i := range events {
+		m, err := p.message(events[i])
+		if err != nil {
+			return fmt.Errorf("p.message: %w", err)
+		}
+		msgs = append(msgs, m)
+	}
+
+	err := p.writer.WriteMessages(ctx, msgs...)
 	if err != nil {
 		return fmt.Errorf("p.writer.WriteMessages: %w", err)
 	}
 
 	return nil
 }
+
+// message encodes the event, the messages of a link share the key to get into the same partition.
+func (p *Producer) message(e entity.LinkEvent) (kafka.Message, error) {
+	event, err := linkEvent(e)
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	value, err := p.marshal(event)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("p.marshal: %w", err)
+	}
+
+	return kafka.Message{
+		Key:   []byte(e.Alias),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: HeaderEventType, Value: []byte(eventType(event))},
+			{Key: HeaderEventID, Value: []byte(e.EventID.String())},
+			{Key: HeaderEventTime, Value: []byte(e.CreatedAt.UTC().Format(time.RFC3339Nano))},
+			{Key: HeaderContentType, Value: []byte(p.contentType)},
+		},
+	}, nil
+}
+
+// linkEvent converts the event to the message of its type.
+func linkEvent(e entity.LinkEvent) (proto.Message, error) {
+	switch e.Event {
+	case entity.LinkEventCreated:
+		return &pb.LinkCreated{
+			EventId:    e.EventID.String(),
+			OccurredAt: timestamppb.New(e.CreatedAt),
+			Alias:      e.Alias,
+			Url:        e.URL,
+			ExpiredAt:  timestamp(e.ExpiredAt),
+		}, nil
+	case entity.LinkEventUpdated:
+		return &pb.LinkUpdated{
+			EventId:    e.EventID.String(),
+			OccurredAt: timestamppb.New(e.CreatedAt),
+			Alias:      e.Alias,
+			Url:        e.URL,
+			ExpiredAt:  timestamp(e.ExpiredAt),
+		}, nil
+	case entity.LinkEventDeleted:
+		return &pb.LinkDeleted{
+			EventId:    e.EventID.String(),
+			OccurredAt: timestamppb.New(e.CreatedAt),
+			Alias:      e.Alias,
+			Url:        e.URL,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown link event: %q", e.Event)
+	}
+}
+
+func eventType(m proto.Message) protoreflect.FullName {
+	return m.ProtoReflect().Descriptor().FullName()
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
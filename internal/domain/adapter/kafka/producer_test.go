@@ -0,0 +1,167 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	pb "github.com/xgmsx/go-url-shortener-ddd/proto/gen/link_events.v1"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name    string
+		format  string
+		wantErr bool
+	}{
+		{name: "Protobuf", format: FormatProtobuf},
+		{name: "JSON", format: FormatJSON},
+		{name: "Unknown format", format: "avro", wantErr: true},
+		{name: "Empty format", format: "", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := New(Config{Format: tc.format}, nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	eventID := uuid.MustParse("6f1c2a5e-9a53-4c0e-8d51-2b1f8f0b7a10")
+	createdAt := time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)
+	expiredAt := createdAt.Add(24 * time.Hour)
+
+	newEvent := func(event string, expiredAt time.Time) entity.LinkEvent {
+		return entity.LinkEvent{
+			ID:        1,
+			EventID:   eventID,
+			Event:     event,
+			Alias:     "alias",
+			URL:       "https://example.com",
+			ExpiredAt: expiredAt,
+			CreatedAt: createdAt,
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		format          string
+		event           entity.LinkEvent
+		wantType        string
+		wantContentType string
+		want            proto.Message
+		wantErr         bool
+	}{
+		{
+			name:            "Created protobuf",
+			format:          FormatProtobuf,
+			event:           newEvent(entity.LinkEventCreated, expiredAt),
+			wantType:        "link_events_v1.LinkCreated",
+			wantContentType: ContentTypeProtobuf,
+			want: &pb.LinkCreated{
+				EventId:    eventID.String(),
+				OccurredAt: timestamppb.New(createdAt),
+				Alias:      "alias",
+				Url:        "https://example.com",
+				ExpiredAt:  timestamppb.New(expiredAt),
+			},
+		},
+		{
+			name:            "Created without expiration json",
+			format:          FormatJSON,
+			event:           newEvent(entity.LinkEventCreated, time.Time{}),
+			wantType:        "link_events_v1.LinkCreated",
+			wantContentType: ContentTypeJSON,
+			want: &pb.LinkCreated{
+				EventId:    eventID.String(),
+				OccurredAt: timestamppb.New(createdAt),
+				Alias:      "alias",
+				Url:        "https://example.com",
+			},
+		},
+		{
+			name:            "Updated json",
+			format:          FormatJSON,
+			event:           newEvent(entity.LinkEventUpdated, expiredAt),
+			wantType:        "link_events_v1.LinkUpdated",
+			wantContentType: ContentTypeJSON,
+			want: &pb.LinkUpdated{
+				EventId:    eventID.String(),
+				OccurredAt: timestamppb.New(createdAt),
+				Alias:      "alias",
+				Url:        "https://example.com",
+				ExpiredAt:  timestamppb.New(expiredAt),
+			},
+		},
+		{
+			name:            "Deleted protobuf",
+			format:          FormatProtobuf,
+			event:           newEvent(entity.LinkEventDeleted, expiredAt),
+			wantType:        "link_events_v1.LinkDeleted",
+			wantContentType: ContentTypeProtobuf,
+			want: &pb.LinkDeleted{
+				EventId:    eventID.String(),
+				OccurredAt: timestamppb.New(createdAt),
+				Alias:      "alias",
+				Url:        "https://example.com",
+			},
+		},
+		{
+			name:    "Unknown event",
+			format:  FormatProtobuf,
+			event:   newEvent("renamed", time.Time{}),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := New(Config{Format: tc.format}, nil)
+			require.NoError(t, err)
+
+			m, err := p.message(tc.event)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "alias", string(m.Key))
+			assert.Equal(t, tc.wantType, header(m, HeaderEventType))
+			assert.Equal(t, eventID.String(), header(m, HeaderEventID))
+			assert.Equal(t, "2026-10-18T10:30:00Z", header(m, HeaderEventTime))
+			assert.Equal(t, tc.wantContentType, header(m, HeaderContentType))
+
+			got := tc.want.ProtoReflect().New().Interface()
+			if tc.format == FormatJSON {
+				require.NoError(t, protojson.Unmarshal(m.Value, got))
+			} else {
+				require.NoError(t, proto.Unmarshal(m.Value, got))
+			}
+			assert.True(t, proto.Equal(tc.want, got))
+		})
+	}
+}
+
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
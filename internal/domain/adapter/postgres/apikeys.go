@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+func (p *Postgres) CreateAPIKey(ctx context.Context, key entity.APIKey) error {
+	ctx, span := tracer.Start(ctx, "postgres CreateAPIKey")
+	defer span.End()
+
+	dataset := goqu.Insert("api_keys").Rows(goqu.Record{
+		"id":         key.ID,
+		"owner_id":   key.OwnerID,
+		"name":       key.Name,
+		"key_hash":   key.Hash,
+		"created_at": key.CreatedAt,
+	})
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = p.pool.Exec(ctx, sql)
+	if err != nil {
+		return fmt.Errorf("p.pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// FindAPIKey returns the active key with the hash.
+func (p *Postgres) FindAPIKey(ctx context.Context, hash string) (*entity.APIKey, error) {
+	ctx, span := tracer.Start(ctx, "postgres FindAPIKey")
+	defer span.End()
+
+	dataset := goqu.
+		Select("id", "owner_id", "name", "key_hash", "created_at").
+		From("api_keys").
+		Where(goqu.C("key_hash").Eq(hash), goqu.C("revoked_at").IsNull())
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var key entity.APIKey
+	err = p.pool.QueryRow(ctx, sql).Scan(&key.ID, &key.OwnerID, &key.Name, &key.Hash, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrNotFound
+		}
+		return nil, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	return &key, nil
+}
+
+// RevokeAPIKey revokes the active key, entity.ErrNotFound is returned for unknown and revoked keys.
+func (p *Postgres) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "postgres RevokeAPIKey")
+	defer span.End()
+
+	dataset := goqu.
+		Update("api_keys").
+		Set(goqu.Record{"revoked_at": time.Now()}).
+		Where(goqu.C("id").Eq(id), goqu.C("revoked_at").IsNull())
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	tag, err := p.pool.Exec(ctx, sql)
+	if err != nil {
+		return fmt.Errorf("p.pool.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entity.ErrNotFound
+	}
+
+	return nil
+}
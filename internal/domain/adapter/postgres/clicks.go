@@ -0,0 +1,345 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+const (
+	day   = 24 * time.Hour
+	week  = 7 * day
+	month = 30 * day
+)
+
+// hourBucket truncates clicked_at to the hour regardless of the session time zone.
+var hourBucket = goqu.L("date_trunc('hour', clicked_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'")
+
+// SaveClicks inserts the clicks in one round trip. The link id is resolved by alias,
+// so clicks of links deleted in the meantime are skipped.
+func (p *Postgres) SaveClicks(ctx context.Context, clicks []entity.Click) error {
+	ctx, span := tracer.Start(ctx, "postgres SaveClicks")
+	defer span.End()
+
+	batch := &pgx.Batch{}
+	for _, c := range clicks {
+		link := goqu.
+			From("links").
+			Select(
+				goqu.C("id"),
+				goqu.V(c.Referrer),
+				goqu.V(c.UserAgent),
+				goqu.Cast(goqu.V(c.ClickedAt), "TIMESTAMPTZ"),
+				goqu.V(c.Country),
+				goqu.V(c.ReferrerDomain),
+				goqu.V(c.Browser),
+				goqu.V(c.OS),
+				goqu.V(c.Device),
+			).
+			Where(goqu.C("alias").Eq(c.Alias))
+
+		dataset := goqu.
+			Insert("clicks").
+			Cols("link_id", "referrer", "user_agent", "clicked_at", "country", "referrer_domain", "browser", "os", "device").
+			FromQuery(link)
+
+		sql, _, err := dataset.ToSQL()
+		if err != nil {
+			return fmt.Errorf("dataset.ToSQL: %w", err)
+		}
+		batch.Queue(sql)
+	}
+
+	err := p.pool.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return fmt.Errorf("p.pool.SendBatch: %w", err)
+	}
+
+	return nil
+}
+
+// GetLinkStats returns the click totals and the top breakdowns of the link.
+// The totals include the rolled up clicks, the windows and breakdowns are computed from raw clicks.
+func (p *Postgres) GetLinkStats(ctx context.Context, linkID uuid.UUID, now time.Time, top int) (entity.LinkStats, error) {
+	ctx, span := tracer.Start(ctx, "postgres GetLinkStats")
+	defer span.End()
+
+	var stats entity.LinkStats
+
+	rolledUpTo := goqu.From("clicks_rollup_state").Select("rolled_up_to")
+
+	dataset := goqu.
+		From("clicks").
+		Select(
+			goqu.L("COUNT(*) FILTER (WHERE clicked_at >= ?)", rolledUpTo),
+			goqu.MIN("clicked_at"),
+			goqu.MAX("clicked_at"),
+			goqu.L("COUNT(*) FILTER (WHERE clicked_at >= ?)", now.Add(-day)),
+			goqu.L("COUNT(*) FILTER (WHERE clicked_at >= ?)", now.Add(-week)),
+			goqu.L("COUNT(*) FILTER (WHERE clicked_at >= ?)", now.Add(-month)),
+		).
+		Where(goqu.C("link_id").Eq(linkID))
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return stats, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var firstClickAt, lastClickAt *time.Time
+	err = p.pool.QueryRow(ctx, sql).Scan(
+		&stats.TotalClicks, &firstClickAt, &lastClickAt, &stats.ClicksDay, &stats.ClicksWeek, &stats.ClicksMonth,
+	)
+	if err != nil {
+		return stats, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	// raw clicks that are already rolled up may have been removed by the retention policy
+	dataset = goqu.
+		From("clicks_hourly").
+		Select(goqu.Cast(goqu.COALESCE(goqu.SUM("clicks"), 0), "BIGINT"), goqu.MIN("bucket"), goqu.MAX("bucket")).
+		Where(goqu.C("link_id").Eq(linkID), goqu.C("bucket").Lt(rolledUpTo))
+
+	sql, _, err = dataset.ToSQL()
+	if err != nil {
+		return stats, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var rolledUpClicks int64
+	var firstBucket, lastBucket *time.Time
+	err = p.pool.QueryRow(ctx, sql).Scan(&rolledUpClicks, &firstBucket, &lastBucket)
+	if err != nil {
+		return stats, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	stats.TotalClicks += rolledUpClicks
+	if firstBucket != nil && (firstClickAt == nil || firstBucket.Before(firstClickAt.Truncate(time.Hour))) {
+		firstClickAt = firstBucket
+	}
+	if lastClickAt == nil {
+		lastClickAt = lastBucket
+	}
+
+	if firstClickAt != nil {
+		stats.FirstClickAt = *firstClickAt
+	}
+	if lastClickAt != nil {
+		stats.LastClickAt = *lastClickAt
+	}
+
+	breakdowns := []struct {
+		column  string
+		entries *[]entity.StatsEntry
+	}{
+		{"country", &stats.Countries},
+		{"referrer_domain", &stats.Referrers},
+		{"browser", &stats.Browsers},
+		{"os", &stats.OS},
+		{"device", &stats.Devices},
+	}
+
+	batch := &pgx.Batch{}
+	for _, b := range breakdowns {
+		dataset = goqu.
+			From("clicks").
+			Select(goqu.C(b.column), goqu.COUNT(goqu.Star()).As("clicks")).
+			Where(goqu.C("link_id").Eq(linkID)).
+			GroupBy(goqu.C(b.column)).
+			Order(goqu.C("clicks").Desc(), goqu.C(b.column).Asc()).
+			Limit(uint(top))
+
+		sql, _, err = dataset.ToSQL()
+		if err != nil {
+			return stats, fmt.Errorf("dataset.ToSQL: %w", err)
+		}
+
+		entries := b.entries
+		batch.Queue(sql).Query(func(rows pgx.Rows) error {
+			var scanErr error
+			*entries, scanErr = pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.StatsEntry, error) {
+				var e entity.StatsEntry
+				return e, row.Scan(&e.Value, &e.Clicks)
+			})
+			return scanErr
+		})
+	}
+
+	err = p.pool.SendBatch(ctx, batch).Close()
+	if err != nil {
+		return stats, fmt.Errorf("p.pool.SendBatch: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetClickHistogram returns the hourly click counts of the link in [from, to),
+// merging the rollups with the raw clicks that are not rolled up yet.
+func (p *Postgres) GetClickHistogram(ctx context.Context, linkID uuid.UUID, from, to time.Time) ([]entity.ClickBucket, error) {
+	ctx, span := tracer.Start(ctx, "postgres GetClickHistogram")
+	defer span.End()
+
+	rolledUpTo := goqu.From("clicks_rollup_state").Select("rolled_up_to")
+
+	rolledUp := goqu.
+		From("clicks_hourly").
+		Select(goqu.C("bucket"), goqu.C("clicks")).
+		Where(
+			goqu.C("link_id").Eq(linkID),
+			goqu.C("bucket").Gte(from.Truncate(time.Hour)),
+			goqu.C("bucket").Lt(to),
+			goqu.C("bucket").Lt(rolledUpTo),
+		)
+
+	raw := goqu.
+		From("clicks").
+		Select(hourBucket.As("bucket"), goqu.COUNT(goqu.Star()).As("clicks")).
+		Where(
+			goqu.C("link_id").Eq(linkID),
+			goqu.C("clicked_at").Gte(from),
+			goqu.C("clicked_at").Lt(to),
+			goqu.C("clicked_at").Gte(rolledUpTo),
+		).
+		GroupBy(goqu.C("bucket"))
+
+	dataset := goqu.
+		From(rolledUp.UnionAll(raw).As("h")).
+		Select(goqu.C("bucket"), goqu.Cast(goqu.SUM("clicks"), "BIGINT")).
+		GroupBy(goqu.C("bucket")).
+		Order(goqu.C("bucket").Asc())
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	rows, err := p.pool.Query(ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("p.pool.Query: %w", err)
+	}
+
+	buckets, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.ClickBucket, error) {
+		var b entity.ClickBucket
+		return b, row.Scan(&b.Start, &b.Clicks)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	return buckets, nil
+}
+
+// RollupClicks counts the raw clicks made before the given time into the hourly rollups
+// and moves the rollup watermark forward. Concurrent calls are serialized by the watermark row lock.
+func (p *Postgres) RollupClicks(ctx context.Context, before time.Time) error {
+	ctx, span := tracer.Start(ctx, "postgres RollupClicks")
+	defer span.End()
+
+	before = before.Truncate(time.Hour)
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	sql, _, err := goqu.
+		From("clicks_rollup_state").
+		Select("rolled_up_to").
+		ForUpdate(exp.Wait).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var rolledUpTo time.Time
+	err = tx.QueryRow(ctx, sql).Scan(&rolledUpTo)
+	if err != nil {
+		return fmt.Errorf("row.Scan: %w", err)
+	}
+
+	if !before.After(rolledUpTo) {
+		return nil
+	}
+
+	clicks := goqu.
+		From("clicks").
+		Select(goqu.C("link_id"), hourBucket.As("bucket"), goqu.COUNT(goqu.Star())).
+		Where(goqu.C("clicked_at").Gte(rolledUpTo), goqu.C("clicked_at").Lt(before)).
+		GroupBy(goqu.C("link_id"), goqu.C("bucket"))
+
+	dataset := goqu.
+		Insert("clicks_hourly").
+		Cols("link_id", "bucket", "clicks").
+		FromQuery(clicks).
+		OnConflict(goqu.DoUpdate("link_id, bucket", goqu.Record{
+			"clicks": goqu.L("clicks_hourly.clicks + EXCLUDED.clicks"),
+		}))
+
+	sql, _, err = dataset.ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql)
+	if err != nil {
+		return fmt.Errorf("tx.Exec: %w", err)
+	}
+
+	sql, _, err = goqu.
+		Update("clicks_rollup_state").
+		Set(goqu.Record{"rolled_up_to": before}).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql)
+	if err != nil {
+		return fmt.Errorf("tx.Exec: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteClicks deletes up to limit raw clicks made before the given time.
+// Clicks that are not rolled up yet are kept.
+func (p *Postgres) DeleteClicks(ctx context.Context, before time.Time, limit int) (int64, error) {
+	ctx, span := tracer.Start(ctx, "postgres DeleteClicks")
+	defer span.End()
+
+	rolledUpTo := goqu.From("clicks_rollup_state").Select("rolled_up_to")
+
+	old := goqu.
+		From("clicks").
+		Select("id").
+		Where(goqu.C("clicked_at").Lt(goqu.Func("LEAST", goqu.Cast(goqu.V(before), "TIMESTAMPTZ"), rolledUpTo))).
+		Limit(uint(limit))
+
+	dataset := goqu.
+		Delete("clicks").
+		Where(goqu.C("id").In(old))
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	tag, err := p.pool.Exec(ctx, sql)
+	if err != nil {
+		return 0, fmt.Errorf("p.pool.Exec: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
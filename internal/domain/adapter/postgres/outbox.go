@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+const outboxTable = "link_events_outbox"
+
+// outboxLockID is the advisory lock of the relay, it is out of the int4 range of the hashtext locks of the links.
+const outboxLockID int64 = 0x6f7574626f78 // "outbox"
+
+// RelayLinkEvents takes up to limit events of the outbox that are due to be published and passes them
+// to publish in the order of creation. Published events are deleted, otherwise the events are rescheduled
+// after the backoff of their attempt and the publish error is returned.
+//
+// The events of a link are published in order: an event is not taken while an earlier event of the same alias
+// waits for a retry. Relays are serialized by a transaction-level advisory lock, a relay that doesn't get it
+// publishes nothing, and the events of a crashed relay are published again.
+func (p *Postgres) RelayLinkEvents(
+	ctx context.Context,
+	limit int,
+	publish func(context.Context, []entity.LinkEvent) error,
+	backoff func(attempt int) time.Duration,
+) (int, error) {
+	ctx, span := tracer.Start(ctx, "postgres RelayLinkEvents")
+	defer span.End()
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	sql, _, err := goqu.Select(goqu.Func("pg_try_advisory_xact_lock", outboxLockID)).ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var locked bool
+	if err = tx.QueryRow(ctx, sql).Scan(&locked); err != nil {
+		return 0, fmt.Errorf("row.Scan: %w", err)
+	}
+	if !locked {
+		// another relay is publishing the events
+		return 0, nil
+	}
+
+	// the events waiting for a retry hold back the later events of their links
+	postponed := goqu.
+		From(goqu.T(outboxTable).As("earlier")).
+		Select(goqu.L("1")).
+		Where(
+			goqu.I("earlier.alias").Eq(goqu.I(outboxTable+".alias")),
+			goqu.I("earlier.id").Lt(goqu.I(outboxTable+".id")),
+			goqu.I("earlier.next_attempt_at").Gt(goqu.L("NOW()")),
+		)
+
+	sql, _, err = goqu.
+		Select("id", "event_id", "event", "alias", "url", "expired_at", "created_at", "attempts").
+		From(outboxTable).
+		Where(
+			goqu.C("next_attempt_at").Lte(goqu.L("NOW()")),
+			goqu.L("NOT EXISTS ?", postponed),
+		).
+		Order(goqu.C("id").Asc()).
+		Limit(uint(limit)).
+		ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sql)
+	if err != nil {
+		return 0, fmt.Errorf("tx.Query: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.LinkEvent, error) {
+		var (
+			e         entity.LinkEvent
+			expiredAt *time.Time
+		)
+		if err := row.Scan(&e.ID, &e.EventID, &e.Event, &e.Alias, &e.URL, &expiredAt, &e.CreatedAt, &e.Attempts); err != nil {
+			return e, err
+		}
+		if expiredAt != nil {
+			e.ExpiredAt = *expiredAt
+		}
+		return e, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	if publishErr := publish(ctx, events); publishErr != nil {
+		if err = rescheduleLinkEvents(ctx, tx, events, backoff, publishErr); err != nil {
+			return 0, err
+		}
+		if err = tx.Commit(ctx); err != nil {
+			return 0, fmt.Errorf("tx.Commit: %w", err)
+		}
+		return 0, fmt.Errorf("publish: %w", publishErr)
+	}
+
+	ids := make([]int64, 0, len(events))
+	for i := range events {
+		ids = append(ids, events[i].ID)
+	}
+
+	sql, _, err = goqu.Delete(outboxTable).Where(goqu.C("id").In(ids)).ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql)
+	if err != nil {
+		return 0, fmt.Errorf("tx.Exec: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return len(events), nil
+}
+
+// LinkEventsStats returns the number of the events waiting in the outbox and the age of the oldest one.
+func (p *Postgres) LinkEventsStats(ctx context.Context) (entity.OutboxStats, error) {
+	ctx, span := tracer.Start(ctx, "postgres LinkEventsStats")
+	defer span.End()
+
+	sql, _, err := goqu.
+		Select(goqu.COUNT(goqu.Star()), goqu.MIN("created_at")).
+		From(outboxTable).
+		ToSQL()
+	if err != nil {
+		return entity.OutboxStats{}, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var (
+		stats    entity.OutboxStats
+		oldestAt *time.Time
+	)
+	if err = p.pool.QueryRow(ctx, sql).Scan(&stats.Pending, &oldestAt); err != nil {
+		return stats, fmt.Errorf("row.Scan: %w", err)
+	}
+	if oldestAt != nil {
+		stats.OldestAt = *oldestAt
+	}
+
+	return stats, nil
+}
+
+// insertLinkEvents writes the events of the links to the outbox within the transaction of the change.
+func insertLinkEvents(ctx context.Context, q querier, event string, links ...entity.Link) error {
+	if len(links) == 0 {
+		return nil
+	}
+
+	records := make([]any, 0, len(links))
+	for i := range links {
+		e := entity.NewLinkEvent(event, links[i])
+		records = append(records, goqu.Record{
+			"event_id":   e.EventID,
+			"event":      e.Event,
+			"alias":      e.Alias,
+			"url":        e.URL,
+			"expired_at": nullTime(e.ExpiredAt),
+		})
+	}
+
+	sql, _, err := goqu.Insert(outboxTable).Rows(records...).ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = q.Exec(ctx, sql)
+	if err != nil {
+		return fmt.Errorf("q.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// rescheduleLinkEvents counts the failed attempt of the events and postpones them by the backoff of the attempt.
+func rescheduleLinkEvents(
+	ctx context.Context, q querier, events []entity.LinkEvent, backoff func(attempt int) time.Duration, cause error,
+) error {
+	// the events of the same attempt are postponed together
+	byAttempt := make(map[int][]int64)
+	for i := range events {
+		attempt := events[i].Attempts + 1
+		byAttempt[attempt] = append(byAttempt[attempt], events[i].ID)
+	}
+
+	now := time.Now()
+	for attempt, ids := range byAttempt {
+		sql, _, err := goqu.
+			Update(outboxTable).
+			Set(goqu.Record{
+				"attempts":        attempt,
+				"next_attempt_at": now.Add(backoff(attempt)),
+				"last_error":      cause.Error(),
+			}).
+			Where(goqu.C("id").In(ids)).
+			ToSQL()
+		if err != nil {
+			return fmt.Errorf("dataset.ToSQL: %w", err)
+		}
+
+		_, err = q.Exec(ctx, sql)
+		if err != nil {
+			return fmt.Errorf("q.Exec: %w", err)
+		}
+	}
+
+	return nil
+}
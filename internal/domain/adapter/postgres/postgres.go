@@ -4,16 +4,44 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
 )
 
+const (
+	codeUniqueViolation   = "23505"
+	constraintUniqueAlias = "links_alias_key"
+)
+
+// insertBatchSize limits the rows of a multi-row insert.
+const insertBatchSize = 500
+
+var linkColumns = []any{
+	"id", "url", "alias", "expired_at", "redirect_code", "forward_query", "password_hash", "max_clicks", "blocked", "owner_id", "created_at",
+}
+
+// expiryKey orders links that never expire after all other links, it matches the expression of the indexes.
+var expiryKey = goqu.L("COALESCE(expired_at, 'infinity'::timestamptz)")
+
+// likeEscaper escapes the wildcards of LIKE patterns.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 type Postgres struct {
 	pool *pgxpool.Pool
 }
@@ -24,39 +52,176 @@ func New(p *pgxpool.Pool) *Postgres {
 	}
 }
 
+// CreateLink inserts the link along with its created event.
 func (p *Postgres) CreateLink(ctx context.Context, link entity.Link) error {
 	ctx, span := tracer.Start(ctx, "postgres CreateLink")
 	defer span.End()
 
-	dataset := goqu.Insert("links").Rows(goqu.Record{
-		"id":         link.ID,
-		"url":        link.URL,
-		"alias":      link.Alias,
-		"updated_at": time.Now(),
-		"expired_at": link.ExpiredAt,
-	})
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
 
-	sql, _, err := dataset.ToSQL()
+	err = insertLink(ctx, tx, link)
 	if err != nil {
-		return fmt.Errorf("dataset.ToSQL: %w", err)
+		return err
 	}
 
-	_, err = p.pool.Exec(ctx, sql)
+	err = insertLinkEvents(ctx, tx, entity.LinkEventCreated, link)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
 	if err != nil {
-		return fmt.Errorf("r.pool.Exec: %w", err)
+		return fmt.Errorf("tx.Commit: %w", err)
 	}
 
 	return nil
 }
 
+// CreateLinks inserts the links along with their created events in one transaction and reports
+// for each link whether it is created, the links with the aliases that are already taken are skipped.
+func (p *Postgres) CreateLinks(ctx context.Context, links []entity.Link) ([]bool, error) {
+	ctx, span := tracer.Start(ctx, "postgres CreateLinks")
+	defer span.End()
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	inserted := make(map[uuid.UUID]struct{}, len(links))
+	for start := 0; start < len(links); start += insertBatchSize {
+		chunk := links[start:min(start+insertBatchSize, len(links))]
+
+		records := make([]any, 0, len(chunk))
+		for i := range chunk {
+			records = append(records, linkRecord(chunk[i]))
+		}
+
+		sql, _, err := goqu.
+			Insert("links").
+			Rows(records...).
+			OnConflict(goqu.DoNothing()).
+			Returning("id").
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("dataset.ToSQL: %w", err)
+		}
+
+		rows, err := tx.Query(ctx, sql)
+		if err != nil {
+			return nil, fmt.Errorf("tx.Query: %w", err)
+		}
+
+		ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+		if err != nil {
+			return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+		}
+		for _, id := range ids {
+			inserted[id] = struct{}{}
+		}
+	}
+
+	created := make([]bool, len(links))
+	createdLinks := make([]entity.Link, 0, len(inserted))
+	for i := range links {
+		if _, created[i] = inserted[links[i].ID]; created[i] {
+			createdLinks = append(createdLinks, links[i])
+		}
+	}
+
+	err = insertLinkEvents(ctx, tx, entity.LinkEventCreated, createdLinks...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return created, nil
+}
+
+// FindOrCreateLink creates the link unless there is an unexpired link of the same owner with the same URL
+// and redirect options, without password and click limit.
+// In that case the existing link is returned with entity.ErrAlreadyExist.
+// Concurrent calls for the same URL are serialized by a transaction-level advisory lock.
+func (p *Postgres) FindOrCreateLink(ctx context.Context, link entity.Link) (entity.Link, error) {
+	ctx, span := tracer.Start(ctx, "postgres FindOrCreateLink")
+	defer span.End()
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return link, fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	sql, _, err := goqu.Select(goqu.Func("pg_advisory_xact_lock", goqu.Func("hashtext", link.URL))).ToSQL()
+	if err != nil {
+		return link, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql)
+	if err != nil {
+		return link, fmt.Errorf("tx.Exec: %w", err)
+	}
+
+	sql, _, err = goqu.
+		Select(linkColumns...).
+		From("links").
+		Where(
+			goqu.C("url").Eq(link.URL),
+			goqu.Or(goqu.C("expired_at").IsNull(), goqu.C("expired_at").Gt(goqu.L("NOW()"))),
+			goqu.C("redirect_code").Eq(link.RedirectCode),
+			goqu.C("forward_query").Eq(link.ForwardQuery),
+			goqu.C("password_hash").Eq(""),
+			goqu.C("max_clicks").Eq(0),
+			ownedBy(link.OwnerID),
+		).
+		Order(goqu.C("created_at").Desc()).
+		Limit(1).
+		ToSQL()
+	if err != nil {
+		return link, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	existing, err := scanLink(tx.QueryRow(ctx, sql))
+	switch {
+	case err == nil:
+		return *existing, entity.ErrAlreadyExist
+	case !errors.Is(err, entity.ErrNotFound):
+		return link, fmt.Errorf("scanLink: %w", err)
+	}
+
+	err = insertLink(ctx, tx, link)
+	if err != nil {
+		return link, err
+	}
+
+	err = insertLinkEvents(ctx, tx, entity.LinkEventCreated, link)
+	if err != nil {
+		return link, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return link, fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return link, nil
+}
+
 func (p *Postgres) FindLink(ctx context.Context, alias, url string) (*entity.Link, error) {
 	ctx, span := tracer.Start(ctx, "postgres FindLink")
 	defer span.End()
 
-	var link entity.Link
-
 	dataset := goqu.
-		Select("id", "url", "alias", "expired_at").
+		Select(linkColumns...).
 		From("links")
 
 	switch {
@@ -75,13 +240,454 @@ func (p *Postgres) FindLink(ctx context.Context, alias, url string) (*entity.Lin
 		return nil, fmt.Errorf("dataset.ToSQL: %w", err)
 	}
 
-	row := p.pool.QueryRow(ctx, sql)
-	if err := row.Scan(&link.ID, &link.URL, &link.Alias, &link.ExpiredAt); err != nil {
+	return scanLink(p.pool.QueryRow(ctx, sql))
+}
+
+// ConsumeClick takes one of the clicks left of a limited link and returns the number of remaining clicks.
+// The conditional update is atomic, so concurrent redirects can't overshoot the limit.
+func (p *Postgres) ConsumeClick(ctx context.Context, alias string) (int, error) {
+	ctx, span := tracer.Start(ctx, "postgres ConsumeClick")
+	defer span.End()
+
+	dataset := goqu.
+		Update("links").
+		Set(goqu.Record{"clicks_left": goqu.L("clicks_left - 1")}).
+		Where(
+			goqu.C("alias").Eq(alias),
+			goqu.C("max_clicks").Gt(0),
+			goqu.C("clicks_left").Gt(0),
+		).
+		Returning("clicks_left")
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var left int
+	err = p.pool.QueryRow(ctx, sql).Scan(&left)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, entity.ErrExhausted
+		}
+		return 0, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	return left, nil
+}
+
+func (p *Postgres) NextAliasID(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Start(ctx, "postgres NextAliasID")
+	defer span.End()
+
+	sql, _, err := goqu.Select(goqu.Func("nextval", "links_alias_seq")).ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	var id int64
+	if err = p.pool.QueryRow(ctx, sql).Scan(&id); err != nil {
+		return 0, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	return id, nil
+}
+
+// DeleteExpiredLinks deletes up to limit links expired before the time along with their deleted events.
+func (p *Postgres) DeleteExpiredLinks(ctx context.Context, before time.Time, limit int) ([]entity.Link, error) {
+	ctx, span := tracer.Start(ctx, "postgres DeleteExpiredLinks")
+	defer span.End()
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// SKIP LOCKED lets several instances reap concurrently without waiting for each other
+	expired := goqu.
+		Select("id").
+		From("links").
+		Where(goqu.C("expired_at").Lte(before)).
+		Order(goqu.C("expired_at").Asc()).
+		Limit(uint(limit)).
+		ForUpdate(exp.SkipLocked)
+
+	dataset := goqu.
+		Delete("links").
+		Where(goqu.C("id").In(expired)).
+		Returning(linkColumns...)
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("tx.Query: %w", err)
+	}
+
+	links, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (entity.Link, error) {
+		link, err := scanLink(row)
+		if err != nil {
+			return entity.Link{}, err
+		}
+		return *link, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	err = insertLinkEvents(ctx, tx, entity.LinkEventDeleted, links...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return links, nil
+}
+
+// ListLinksAfter returns up to limit unexpired links ordered by id, starting after afterID.
+func (p *Postgres) ListLinksAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]entity.Link, error) {
+	ctx, span := tracer.Start(ctx, "postgres ListLinksAfter")
+	defer span.End()
+
+	dataset := goqu.
+		Select(linkColumns...).
+		From("links").
+		Where(
+			goqu.C("id").Gt(afterID),
+			goqu.Or(goqu.C("expired_at").IsNull(), goqu.C("expired_at").Gt(goqu.L("NOW()"))),
+		).
+		Order(goqu.C("id").Asc()).
+		Limit(uint(limit))
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	rows, err := p.pool.Query(ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("p.pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	var links []entity.Link
+	for rows.Next() {
+		var link *entity.Link
+		if link, err = scanLink(rows); err != nil {
+			return nil, err
+		}
+		links = append(links, *link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return links, nil
+}
+
+// ListLinks returns a page of links selected by the filter ordered by the sort key and id.
+// The pages are read by the keyset of the last link, it is served by the (owner_id, sort key, id) indexes.
+func (p *Postgres) ListLinks(ctx context.Context, f entity.LinkFilter) ([]entity.Link, error) {
+	ctx, span := tracer.Start(ctx, "postgres ListLinks")
+	defer span.End()
+
+	sortKey := goqu.L("created_at")
+	if f.SortBy == entity.LinkSortExpiredAt {
+		sortKey = expiryKey
+	}
+
+	dataset := goqu.
+		Select(linkColumns...).
+		From("links").
+		Limit(uint(f.Limit))
+
+	if f.OwnerID != uuid.Nil {
+		dataset = dataset.Where(goqu.C("owner_id").Eq(f.OwnerID))
+	}
+	if !f.CreatedFrom.IsZero() {
+		dataset = dataset.Where(goqu.C("created_at").Gte(f.CreatedFrom))
+	}
+	if !f.CreatedTo.IsZero() {
+		dataset = dataset.Where(goqu.C("created_at").Lt(f.CreatedTo))
+	}
+
+	switch f.State {
+	case entity.LinkStateActive:
+		dataset = dataset.Where(goqu.Or(goqu.C("expired_at").IsNull(), goqu.C("expired_at").Gt(goqu.L("NOW()"))))
+	case entity.LinkStateExpired:
+		dataset = dataset.Where(goqu.C("expired_at").Lte(goqu.L("NOW()")))
+	}
+
+	if f.Domain != "" {
+		dataset = dataset.Where(goqu.C("domain").Like("%" + likeEscaper.Replace(strings.ToLower(f.Domain)) + "%"))
+	}
+
+	if f.After != nil {
+		var after any = f.After.Time
+		if f.SortBy == entity.LinkSortExpiredAt && f.After.Time.IsZero() {
+			after = goqu.L("'infinity'::timestamptz")
+		}
+
+		op := ">"
+		if f.Desc {
+			op = "<"
+		}
+		dataset = dataset.Where(goqu.L("(?, ?) "+op+" (?, ?)", sortKey, goqu.C("id"), after, f.After.ID))
+	}
+
+	if f.Desc {
+		dataset = dataset.Order(sortKey.Desc(), goqu.C("id").Desc())
+	} else {
+		dataset = dataset.Order(sortKey.Asc(), goqu.C("id").Asc())
+	}
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	rows, err := p.pool.Query(ctx, sql)
+	if err != nil {
+		return nil, fmt.Errorf("p.pool.Query: %w", err)
+	}
+	defer rows.Close()
+
+	links := make([]entity.Link, 0, f.Limit)
+	for rows.Next() {
+		var link *entity.Link
+		if link, err = scanLink(rows); err != nil {
+			return nil, err
+		}
+		links = append(links, *link)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return links, nil
+}
+
+// SetLinksBlocked blocks or unblocks the links.
+func (p *Postgres) SetLinksBlocked(ctx context.Context, blocked bool, aliases ...string) error {
+	ctx, span := tracer.Start(ctx, "postgres SetLinksBlocked")
+	defer span.End()
+
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	dataset := goqu.
+		Update("links").
+		Set(goqu.Record{"blocked": blocked, "updated_at": time.Now()}).
+		Where(goqu.C("alias").In(aliases))
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = p.pool.Exec(ctx, sql)
+	if err != nil {
+		return fmt.Errorf("p.pool.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateLink changes the fields of the link along with its updated event in one statement unless it belongs
+// to another owner, uuid.Nil owner updates any link. The updated link is returned.
+func (p *Postgres) UpdateLink(ctx context.Context, alias string, changes entity.LinkChanges, owner uuid.UUID) (entity.Link, error) {
+	ctx, span := tracer.Start(ctx, "postgres UpdateLink")
+	defer span.End()
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return entity.Link{}, fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	record := goqu.Record{"updated_at": time.Now()}
+	if changes.URL != "" {
+		record["url"] = changes.URL
+		// the new URL has already passed the blocklist check, so the flag of the old URL is cleared
+		record["blocked"] = false
+	}
+	if changes.SetExpiry {
+		record["expired_at"] = nullTime(changes.ExpiredAt)
+	}
+
+	dataset := goqu.
+		Update("links").
+		Set(record).
+		Where(goqu.C("alias").Eq(alias)).
+		Returning(linkColumns...)
+
+	if owner != uuid.Nil {
+		dataset = dataset.Where(goqu.C("owner_id").Eq(owner))
+	}
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return entity.Link{}, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	link, err := scanLink(tx.QueryRow(ctx, sql))
+	if err != nil {
+		return entity.Link{}, err
+	}
+
+	err = insertLinkEvents(ctx, tx, entity.LinkEventUpdated, *link)
+	if err != nil {
+		return entity.Link{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return entity.Link{}, fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return *link, nil
+}
+
+// DeleteLink deletes the link along with its deleted event unless it belongs to another owner,
+// uuid.Nil owner deletes any link.
+func (p *Postgres) DeleteLink(ctx context.Context, alias string, owner uuid.UUID) (entity.Link, error) {
+	ctx, span := tracer.Start(ctx, "postgres DeleteLink")
+	defer span.End()
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return entity.Link{}, fmt.Errorf("p.pool.Begin: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	dataset := goqu.
+		Delete("links").
+		Where(goqu.C("alias").Eq(alias)).
+		Returning(linkColumns...)
+
+	if owner != uuid.Nil {
+		dataset = dataset.Where(goqu.C("owner_id").Eq(owner))
+	}
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return entity.Link{}, fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	link, err := scanLink(tx.QueryRow(ctx, sql))
+	if err != nil {
+		return entity.Link{}, err
+	}
+
+	err = insertLinkEvents(ctx, tx, entity.LinkEventDeleted, *link)
+	if err != nil {
+		return entity.Link{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return entity.Link{}, fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return *link, nil
+}
+
+func insertLink(ctx context.Context, q querier, link entity.Link) error {
+	dataset := goqu.Insert("links").Rows(linkRecord(link))
+
+	sql, _, err := dataset.ToSQL()
+	if err != nil {
+		return fmt.Errorf("dataset.ToSQL: %w", err)
+	}
+
+	_, err = q.Exec(ctx, sql)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation {
+			if pgErr.ConstraintName == constraintUniqueAlias {
+				return entity.ErrAliasTaken
+			}
+			return entity.ErrAlreadyExist
+		}
+		return fmt.Errorf("q.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func linkRecord(link entity.Link) goqu.Record {
+	return goqu.Record{
+		"id":            link.ID,
+		"url":           link.URL,
+		"alias":         link.Alias,
+		"created_at":    link.CreatedAt,
+		"updated_at":    time.Now(),
+		"expired_at":    nullTime(link.ExpiredAt),
+		"redirect_code": link.RedirectCode,
+		"forward_query": link.ForwardQuery,
+		"password_hash": link.PasswordHash,
+		"max_clicks":    link.MaxClicks,
+		"clicks_left":   link.MaxClicks,
+		"owner_id":      nullUUID(link.OwnerID),
+	}
+}
+
+// scanLink scans a row selected with linkColumns.
+func scanLink(row pgx.Row) (*entity.Link, error) {
+	var (
+		link      entity.Link
+		expiredAt *time.Time
+		ownerID   *uuid.UUID
+	)
+
+	if err := row.Scan(&link.ID, &link.URL, &link.Alias, &expiredAt, &link.RedirectCode, &link.ForwardQuery, &link.PasswordHash, &link.MaxClicks, &link.Blocked, &ownerID, &link.CreatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, entity.ErrNotFound
 		}
 		return nil, fmt.Errorf("row.Scan: %w", err)
 	}
 
+	if expiredAt != nil {
+		link.ExpiredAt = *expiredAt
+	}
+	if ownerID != nil {
+		link.OwnerID = *ownerID
+	}
+
 	return &link, nil
 }
+
+// nullTime stores links that never expire with NULL expired_at.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// nullUUID stores links without owner with NULL owner_id.
+func nullUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+// ownedBy matches the rows of the owner, uuid.Nil matches the rows without owner.
+func ownedBy(owner uuid.UUID) exp.Expression {
+	if owner == uuid.Nil {
+		return goqu.C("owner_id").IsNull()
+	}
+	return goqu.C("owner_id").Eq(owner)
+}
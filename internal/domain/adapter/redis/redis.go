@@ -27,12 +27,17 @@ func (r *Redis) PutLink(ctx context.Context, link entity.Link) error {
 	ctx, span := tracer.Start(ctx, "redis PutLink")
 	defer span.End()
 
+	linkTTL := cacheTTL(link)
+	if linkTTL <= 0 {
+		return nil
+	}
+
 	data, err := json.Marshal(link)
 	if err != nil {
 		return fmt.Errorf("json.Marshal: %w", err)
 	}
 
-	err = r.client.Set(ctx, link.Alias, data, ttl).Err()
+	err = r.client.Set(ctx, link.Alias, data, linkTTL).Err()
 	if err != nil {
 		return fmt.Errorf("r.client.Set: %w", err)
 	}
@@ -40,6 +45,46 @@ func (r *Redis) PutLink(ctx context.Context, link entity.Link) error {
 	return nil
 }
 
+// PutLinks caches the links in one round trip.
+func (r *Redis) PutLinks(ctx context.Context, links ...entity.Link) error {
+	ctx, span := tracer.Start(ctx, "redis PutLinks")
+	defer span.End()
+
+	if len(links) == 0 {
+		return nil
+	}
+
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i := range links {
+			linkTTL := cacheTTL(links[i])
+			if linkTTL <= 0 {
+				continue
+			}
+
+			data, err := json.Marshal(links[i])
+			if err != nil {
+				return fmt.Errorf("json.Marshal: %w", err)
+			}
+
+			pipe.Set(ctx, links[i].Alias, data, linkTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("r.client.Pipelined: %w", err)
+	}
+
+	return nil
+}
+
+// cacheTTL returns the TTL of the cached link, the cached copy must not outlive the link itself.
+func cacheTTL(link entity.Link) time.Duration {
+	if link.ExpiredAt.IsZero() {
+		return ttl
+	}
+	return min(ttl, time.Until(link.ExpiredAt))
+}
+
 func (r *Redis) GetLink(ctx context.Context, alias string) (*entity.Link, error) {
 	ctx, span := tracer.Start(ctx, "redis GetLink")
 	defer span.End()
@@ -62,3 +107,142 @@ func (r *Redis) GetLink(ctx context.Context, alias string) (*entity.Link, error)
 
 	return &link, nil
 }
+
+func (r *Redis) DeleteLinks(ctx context.Context, aliases ...string) error {
+	ctx, span := tracer.Start(ctx, "redis DeleteLinks")
+	defer span.End()
+
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, 2*len(aliases))
+	for _, alias := range aliases {
+		keys = append(keys, alias, clicksLeftKey(alias))
+	}
+
+	err := r.client.Del(ctx, keys...).Err()
+	if err != nil {
+		return fmt.Errorf("r.client.Del: %w", err)
+	}
+
+	return nil
+}
+
+func clicksLeftKey(alias string) string {
+	return "clicks_left:" + alias
+}
+
+// consumeClick decrements the clicks left unless the link is exhausted,
+// nil is returned for unknown links, -1 for exhausted ones.
+var consumeClick = redis.NewScript(`
+local left = redis.call('GET', KEYS[1])
+if not left then
+	return nil
+end
+if tonumber(left) <= 0 then
+	return -1
+end
+return redis.call('DECR', KEYS[1])
+`)
+
+// ConsumeClick takes one of the clicks left of a limited link and returns the number of remaining clicks.
+// entity.ErrNotFound is returned until the counter is put with PutClicksLeft.
+func (r *Redis) ConsumeClick(ctx context.Context, alias string) (int, error) {
+	ctx, span := tracer.Start(ctx, "redis ConsumeClick")
+	defer span.End()
+
+	left, err := consumeClick.Run(ctx, r.client, []string{clicksLeftKey(alias)}).Int()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, entity.ErrNotFound
+		}
+
+		return 0, fmt.Errorf("consumeClick.Run: %w", err)
+	}
+
+	if left < 0 {
+		return 0, entity.ErrExhausted
+	}
+
+	return left, nil
+}
+
+// refundClick increments the clicks left of a cached counter, an evicted counter is left missing.
+var refundClick = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+return redis.call('INCR', KEYS[1])
+`)
+
+// RefundClick gives back the click taken with ConsumeClick when it could not be taken in the database.
+func (r *Redis) RefundClick(ctx context.Context, alias string) error {
+	ctx, span := tracer.Start(ctx, "redis RefundClick")
+	defer span.End()
+
+	err := refundClick.Run(ctx, r.client, []string{clicksLeftKey(alias)}).Err()
+	if err != nil {
+		return fmt.Errorf("refundClick.Run: %w", err)
+	}
+
+	return nil
+}
+
+// PutClicksLeft sets the counter of clicks left unless another instance has already set it.
+func (r *Redis) PutClicksLeft(ctx context.Context, link entity.Link, left int) error {
+	ctx, span := tracer.Start(ctx, "redis PutClicksLeft")
+	defer span.End()
+
+	counterTTL := cacheTTL(link)
+	if counterTTL <= 0 {
+		return nil
+	}
+
+	err := r.client.SetNX(ctx, clicksLeftKey(link.Alias), left, counterTTL).Err()
+	if err != nil {
+		return fmt.Errorf("r.client.SetNX: %w", err)
+	}
+
+	return nil
+}
+
+func passwordAttemptsKey(alias, client string) string {
+	return "password_attempts:" + alias + ":" + client
+}
+
+// addPasswordAttempt increments the attempts and returns them,
+// the counter expires in the window after the first attempt.
+var addPasswordAttempt = redis.NewScript(`
+local attempts = redis.call('INCR', KEYS[1])
+redis.call('EXPIRE', KEYS[1], ARGV[1], 'NX')
+return attempts
+`)
+
+// AddPasswordAttempt reserves a password attempt before the check and returns the attempts in the window.
+func (r *Redis) AddPasswordAttempt(ctx context.Context, alias, client string, window time.Duration) (int, error) {
+	ctx, span := tracer.Start(ctx, "redis AddPasswordAttempt")
+	defer span.End()
+
+	seconds := max(int64(window/time.Second), 1)
+
+	attempts, err := addPasswordAttempt.Run(ctx, r.client, []string{passwordAttemptsKey(alias, client)}, seconds).Int()
+	if err != nil {
+		return 0, fmt.Errorf("addPasswordAttempt.Run: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// ResetPasswordAttempts forgets the attempts of the client after the correct password.
+func (r *Redis) ResetPasswordAttempts(ctx context.Context, alias, client string) error {
+	ctx, span := tracer.Start(ctx, "redis ResetPasswordAttempts")
+	defer span.End()
+
+	err := r.client.Del(ctx, passwordAttemptsKey(alias, client)).Err()
+	if err != nil {
+		return fmt.Errorf("r.client.Del: %w", err)
+	}
+
+	return nil
+}
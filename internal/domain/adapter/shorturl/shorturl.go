@@ -0,0 +1,33 @@
+package shorturl
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Config struct {
+	BaseURL string `env:"PUBLIC_BASE_URL, default=http://localhost:8000"` // scheme and host serving the short links
+}
+
+// Builder makes ready-to-share links to the root redirect route.
+type Builder struct {
+	base string
+}
+
+func New(c Config) (Builder, error) {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return Builder{}, fmt.Errorf("url.Parse: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Builder{}, fmt.Errorf("invalid public base url: %q", c.BaseURL)
+	}
+
+	return Builder{base: strings.TrimRight(c.BaseURL, "/")}, nil
+}
+
+// Build returns the short URL of the alias.
+func (b Builder) Build(alias string) string {
+	return b.base + "/" + url.PathEscape(alias)
+}
@@ -0,0 +1,36 @@
+package shorturl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	testCases := []struct {
+		name    string
+		baseURL string
+		alias   string
+		want    string
+		wantErr bool
+	}{
+		{name: "Host", baseURL: "https://sho.rt", alias: "abc", want: "https://sho.rt/abc"},
+		{name: "Trailing slash", baseURL: "https://sho.rt/", alias: "abc", want: "https://sho.rt/abc"},
+		{name: "Port", baseURL: "http://localhost:8000", alias: "a-b_c", want: "http://localhost:8000/a-b_c"},
+		{name: "No scheme", baseURL: "sho.rt", wantErr: true},
+		{name: "Unsupported scheme", baseURL: "ftp://sho.rt", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := New(Config{BaseURL: tc.baseURL})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, b.Build(tc.alias))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package useragent
+
+import (
+	"strings"
+
+	"github.com/mssola/useragent"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+type Parser struct{}
+
+func New() *Parser {
+	return &Parser{}
+}
+
+// Parse returns the browser, OS and device class of the User-Agent header.
+func (p *Parser) Parse(ua string) entity.UserAgent {
+	if ua == "" {
+		return entity.UserAgent{}
+	}
+
+	agent := useragent.New(ua)
+	browser, _ := agent.Browser()
+
+	return entity.UserAgent{
+		Browser: browser,
+		OS:      osName(agent),
+		Device:  device(agent),
+	}
+}
+
+// osName reports iPhone and iPad systems as iOS, the parser names them inconsistently.
+func osName(agent *useragent.UserAgent) string {
+	ua := agent.UA()
+	if strings.Contains(ua, "iPhone") || strings.Contains(ua, "iPad") {
+		return "iOS"
+	}
+	return agent.OSInfo().Name
+}
+
+func device(agent *useragent.UserAgent) string {
+	ua := agent.UA()
+	switch {
+	case agent.Bot():
+		return entity.DeviceBot
+	case strings.Contains(ua, "iPad") || strings.Contains(ua, "Tablet") ||
+		(strings.Contains(ua, "Android") && !strings.Contains(ua, "Mobile")):
+		return entity.DeviceTablet
+	case agent.Mobile():
+		return entity.DeviceMobile
+	case agent.OSInfo().Name != "":
+		return entity.DeviceDesktop
+	default:
+		return ""
+	}
+}
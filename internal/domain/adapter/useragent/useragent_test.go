@@ -0,0 +1,49 @@
+package useragent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name string
+		ua   string
+		want entity.UserAgent
+	}{
+		{
+			name: "Desktop",
+			ua:   "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			want: entity.UserAgent{Browser: "Chrome", OS: "Windows", Device: entity.DeviceDesktop},
+		},
+		{
+			name: "Mobile",
+			ua:   "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+			want: entity.UserAgent{Browser: "Safari", OS: "iOS", Device: entity.DeviceMobile},
+		},
+		{
+			name: "Tablet",
+			ua:   "Mozilla/5.0 (iPad; CPU OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+			want: entity.UserAgent{Browser: "Safari", OS: "iOS", Device: entity.DeviceTablet},
+		},
+		{
+			name: "Bot",
+			ua:   "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			want: entity.UserAgent{Browser: "Googlebot", Device: entity.DeviceBot},
+		},
+		{
+			name: "Empty",
+			ua:   "",
+			want: entity.UserAgent{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, New().Parse(tc.ua))
+		})
+	}
+}
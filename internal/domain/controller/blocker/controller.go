@@ -0,0 +1,105 @@
+package blocker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/block"
+)
+
+type Config struct {
+	ReloadInterval time.Duration `env:"BLOCKLIST_RELOAD_INTERVAL, default=10s"`
+	BatchSize      int           `env:"BLOCKLIST_SYNC_BATCH_SIZE, default=1000"`
+}
+
+type reloader interface {
+	Enabled() bool
+	Reload() (bool, error)
+}
+
+// Blocker reloads the blocklist file and syncs the blocked links after every change.
+type Blocker struct {
+	config    Config
+	blocklist reloader
+	uc        block.Usecase
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+func New(c Config, b reloader, uc block.Usecase) *Blocker {
+	return &Blocker{config: c, blocklist: b, uc: uc, cancel: func() {}, done: make(chan struct{})}
+}
+
+// Start runs the blocker in background until ctx is canceled or Close is called.
+// The links are synced on start, since the file could change while the service was down.
+func (b *Blocker) Start(ctx context.Context) {
+	if !b.blocklist.Enabled() || b.config.ReloadInterval <= 0 || b.config.BatchSize < 1 {
+		log.Info().Msg("Blocker disabled")
+		close(b.done)
+		return
+	}
+
+	ctx, b.cancel = context.WithCancel(ctx)
+	go b.run(ctx)
+}
+
+func (b *Blocker) Close() {
+	b.cancel()
+	<-b.done
+	log.Info().Msg("Blocker closed")
+}
+
+func (b *Blocker) run(ctx context.Context) {
+	defer close(b.done)
+
+	log.Info().Msg("Blocker started")
+
+	ticker := time.NewTicker(b.config.ReloadInterval)
+	defer ticker.Stop()
+
+	// a failed sync is retried after the next reload even if the file is unchanged
+	synced := b.sync(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := b.blocklist.Reload()
+			if err != nil {
+				reloadsTotal.WithLabelValues("error").Inc()
+				log.Error().Err(err).Msg("blocklist.Reload")
+			} else {
+				reloadsTotal.WithLabelValues("success").Inc()
+			}
+
+			if changed || !synced {
+				synced = b.sync(ctx)
+			}
+		}
+	}
+}
+
+// sync reports whether the links are synced with the blocklist.
+func (b *Blocker) sync(ctx context.Context) bool {
+	start := time.Now()
+	defer func() { syncDuration.Observe(time.Since(start).Seconds()) }()
+
+	output, err := b.uc.Sync(ctx, dto.SyncBlockedLinksInput{BatchSize: b.config.BatchSize})
+	linksBlocked.Add(float64(output.Blocked))
+	linksUnblocked.Add(float64(output.Unblocked))
+	if err != nil {
+		syncsTotal.WithLabelValues("error").Inc()
+		log.Error().Err(err).Msg("uc.Sync")
+		return false
+	}
+
+	syncsTotal.WithLabelValues("success").Inc()
+	if output.Blocked > 0 || output.Unblocked > 0 {
+		log.Info().Int("blocked", output.Blocked).Int("unblocked", output.Unblocked).Msg("Blocked links synced")
+	}
+
+	return true
+}
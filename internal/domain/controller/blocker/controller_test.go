@@ -0,0 +1,93 @@
+package blocker_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	adapterBlocklist "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/blocklist"
+	controllerBlocker "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/blocker"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	ucBlock "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/block"
+	mocksBlock "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/block/mocks"
+)
+
+func TestBlocker(t *testing.T) {
+	id1, id2, id3 := uuid.New(), uuid.New(), uuid.New()
+
+	testCases := []struct {
+		name      string
+		config    controllerBlocker.Config
+		file      bool
+		setupMock func(database *mocksBlock.Mockdatabase, cache *mocksBlock.Mockcache)
+	}{
+		{
+			name:   "Happy path",
+			config: controllerBlocker.Config{ReloadInterval: 10 * time.Millisecond, BatchSize: 2},
+			file:   true,
+			setupMock: func(database *mocksBlock.Mockdatabase, cache *mocksBlock.Mockcache) {
+				first := []entity.Link{
+					{ID: id1, URL: "https://login.evil.com", Alias: "alias1"},
+					{ID: id2, URL: "https://example.com", Alias: "alias2", Blocked: true},
+				}
+				second := []entity.Link{{ID: id3, URL: "https://evil.com", Alias: "alias3", Blocked: true}}
+				// the links are synced once on start, the file is not changed after that
+				database.EXPECT().ListLinksAfter(gomock.Any(), uuid.Nil, 2).Return(first, nil).Times(1)
+				database.EXPECT().ListLinksAfter(gomock.Any(), id2, 2).Return(second, nil).Times(1)
+				database.EXPECT().SetLinksBlocked(gomock.Any(), true, "alias1").Return(nil).Times(1)
+				database.EXPECT().SetLinksBlocked(gomock.Any(), false, "alias2").Return(nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias2").Return(errors.New("test cache error")).Times(1)
+			},
+		},
+		{
+			name:   "Database error is retried",
+			config: controllerBlocker.Config{ReloadInterval: 10 * time.Millisecond, BatchSize: 2},
+			file:   true,
+			setupMock: func(database *mocksBlock.Mockdatabase, cache *mocksBlock.Mockcache) {
+				database.EXPECT().ListLinksAfter(gomock.Any(), uuid.Nil, 2).Return(nil, errors.New("test db error")).MinTimes(2)
+			},
+		},
+		{
+			name:   "Disabled without file",
+			config: controllerBlocker.Config{ReloadInterval: 10 * time.Millisecond, BatchSize: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksBlock.NewMockdatabase(ctrl)
+			cache := mocksBlock.NewMockcache(ctrl)
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			var config adapterBlocklist.Config
+			if tc.file {
+				config.File = filepath.Join(t.TempDir(), "blocklist.txt")
+				require.NoError(t, os.WriteFile(config.File, []byte("evil.com\n"), 0o600))
+			}
+			blocklist, err := adapterBlocklist.New(config)
+			require.NoError(t, err)
+
+			blocker := controllerBlocker.New(tc.config, blocklist, ucBlock.New(database, cache, blocklist))
+
+			// act
+			blocker.Start(context.Background())
+			<-time.After(time.Millisecond * 50)
+			blocker.Close()
+		})
+	}
+}
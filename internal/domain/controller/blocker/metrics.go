@@ -0,0 +1,34 @@
+package blocker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	linksBlocked = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blocker_links_blocked_total",
+		Help: "Count all links blocked after matching the blocklist.",
+	})
+
+	linksUnblocked = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blocker_links_unblocked_total",
+		Help: "Count all links unblocked after removal from the blocklist.",
+	})
+
+	reloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blocker_reloads_total",
+		Help: "Count all blocklist reloads by status.",
+	}, []string{"status"})
+
+	syncsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blocker_syncs_total",
+		Help: "Count all blocked links syncs by status.",
+	}, []string{"status"})
+
+	syncDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "blocker_sync_duration_seconds",
+		Help:    "Duration of blocked links syncs.",
+		Buckets: prometheus.DefBuckets,
+	})
+)
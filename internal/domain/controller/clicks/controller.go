@@ -0,0 +1,128 @@
+package clicks
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+)
+
+type Config struct {
+	BufferSize    int           `env:"CLICKS_BUFFER_SIZE, default=10000"`
+	BatchSize     int           `env:"CLICKS_BATCH_SIZE, default=500"`
+	FlushInterval time.Duration `env:"CLICKS_FLUSH_INTERVAL, default=1s"`
+}
+
+// Recorder saves clicks in background batches, so redirects never wait for the database.
+type Recorder struct {
+	config Config
+	uc     stats.Usecase
+	clicks chan dto.RecordClickInput
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(c Config, uc stats.Usecase) *Recorder {
+	if c.BufferSize < 1 {
+		c.BufferSize = 1
+	}
+	if c.BatchSize < 1 {
+		c.BatchSize = 1
+	}
+	if c.FlushInterval <= 0 {
+		c.FlushInterval = time.Second
+	}
+
+	return &Recorder{
+		config: c,
+		uc:     uc,
+		clicks: make(chan dto.RecordClickInput, c.BufferSize),
+		cancel: func() {},
+		done:   make(chan struct{}),
+	}
+}
+
+// Track queues the click without blocking, the click is dropped if the buffer is full.
+func (r *Recorder) Track(input dto.RecordClickInput) {
+	select {
+	case r.clicks <- input:
+	default:
+		clicksDropped.Inc()
+	}
+}
+
+// Start runs the recorder in background until ctx is canceled or Close is called.
+func (r *Recorder) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	go r.run(ctx)
+}
+
+// Close stops the recorder and saves the buffered clicks.
+func (r *Recorder) Close() {
+	r.cancel()
+	<-r.done
+	log.Info().Msg("Click recorder closed")
+}
+
+func (r *Recorder) run(ctx context.Context) {
+	defer close(r.done)
+
+	log.Info().Msg("Click recorder started")
+
+	ticker := time.NewTicker(r.config.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]dto.RecordClickInput, 0, r.config.BatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			r.drain(batch)
+			return
+		case click := <-r.clicks:
+			batch = append(batch, click)
+			if len(batch) >= r.config.BatchSize {
+				batch = r.flush(ctx, batch)
+			}
+		case <-ticker.C:
+			batch = r.flush(ctx, batch)
+		}
+	}
+}
+
+// drain saves the clicks left in the buffer on shutdown.
+func (r *Recorder) drain(batch []dto.RecordClickInput) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.FlushInterval)
+	defer cancel()
+
+	for {
+		select {
+		case click := <-r.clicks:
+			batch = append(batch, click)
+			if len(batch) >= r.config.BatchSize {
+				batch = r.flush(ctx, batch)
+			}
+		default:
+			r.flush(ctx, batch)
+			return
+		}
+	}
+}
+
+func (r *Recorder) flush(ctx context.Context, batch []dto.RecordClickInput) []dto.RecordClickInput {
+	if len(batch) == 0 {
+		return batch
+	}
+
+	err := r.uc.RecordClicks(ctx, batch)
+	if err != nil {
+		clicksFailed.Add(float64(len(batch)))
+		log.Error().Err(err).Int("clicks", len(batch)).Msg("uc.RecordClicks")
+	} else {
+		clicksRecorded.Add(float64(len(batch)))
+	}
+
+	return batch[:0]
+}
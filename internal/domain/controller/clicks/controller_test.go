@@ -0,0 +1,133 @@
+package clicks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	adapterUserAgent "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/useragent"
+	controllerClicks "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/clicks"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	ucStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	mocksStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats/mocks"
+)
+
+func TestRecorder(t *testing.T) {
+	clicks := []dto.RecordClickInput{
+		{Alias: "alias1", ClickedAt: time.Now()},
+		{Alias: "alias2", ClickedAt: time.Now()},
+		{Alias: "alias1", ClickedAt: time.Now()},
+	}
+
+	testCases := []struct {
+		name      string
+		config    controllerClicks.Config
+		setupMock func(database *mocksStats.Mockdatabase)
+	}{
+		{
+			name:   "Flush by batch size",
+			config: controllerClicks.Config{BufferSize: 10, BatchSize: 3, FlushInterval: time.Hour},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().SaveClicks(gomock.Any(), gomock.Len(3)).Return(nil).Times(1)
+			},
+		},
+		{
+			name:   "Flush by interval",
+			config: controllerClicks.Config{BufferSize: 10, BatchSize: 100, FlushInterval: 10 * time.Millisecond},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().SaveClicks(gomock.Any(), gomock.Len(3)).Return(nil).Times(1)
+			},
+		},
+		{
+			name:   "Flush on close",
+			config: controllerClicks.Config{BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				first := database.EXPECT().SaveClicks(gomock.Any(), gomock.Len(2)).Return(nil).Times(1)
+				database.EXPECT().SaveClicks(gomock.Any(), []entity.Click{{Alias: "alias1", ClickedAt: clicks[2].ClickedAt}}).Return(nil).After(first).Times(1)
+			},
+		},
+		{
+			name:   "Buffer overflow",
+			config: controllerClicks.Config{BufferSize: 1, BatchSize: 100, FlushInterval: time.Hour},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().SaveClicks(gomock.Any(), gomock.Any()).Return(nil).MaxTimes(1)
+			},
+		},
+		{
+			name:   "Database error",
+			config: controllerClicks.Config{BufferSize: 10, BatchSize: 3, FlushInterval: time.Hour},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().SaveClicks(gomock.Any(), gomock.Len(3)).Return(errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksStats.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			geo := mocksStats.NewMockgeoLocator(ctrl)
+			geo.EXPECT().Country(gomock.Any()).Return("").AnyTimes()
+
+			// arrange
+			recorder := controllerClicks.New(tc.config, ucStats.New(database, geo, adapterUserAgent.New()))
+			recorder.Start(context.Background())
+
+			// act
+			for _, click := range clicks {
+				recorder.Track(click)
+			}
+			<-time.After(time.Millisecond * 50)
+			recorder.Close()
+		})
+	}
+}
+
+func TestRecorderEnrichment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clickedAt := time.Now()
+	click := dto.RecordClickInput{
+		Alias:     "alias1",
+		ClickedAt: clickedAt,
+		IP:        "81.2.69.142",
+		Referrer:  "https://www.Example.com/page?q=1",
+		UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+	}
+
+	database := mocksStats.NewMockdatabase(ctrl)
+	database.EXPECT().SaveClicks(gomock.Any(), []entity.Click{{
+		Alias:          "alias1",
+		ClickedAt:      clickedAt,
+		Referrer:       click.Referrer,
+		UserAgent:      click.UserAgent,
+		Country:        "GB",
+		ReferrerDomain: "example.com",
+		Browser:        "Chrome",
+		OS:             "Windows",
+		Device:         entity.DeviceDesktop,
+	}}).Return(nil).Times(1)
+
+	geo := mocksStats.NewMockgeoLocator(ctrl)
+	geo.EXPECT().Country("81.2.69.142").Return("GB").Times(1)
+
+	// arrange
+	recorder := controllerClicks.New(controllerClicks.Config{BufferSize: 1, BatchSize: 1}, ucStats.New(database, geo, adapterUserAgent.New()))
+	recorder.Start(context.Background())
+
+	// act
+	recorder.Track(click)
+	<-time.After(time.Millisecond * 50)
+	recorder.Close()
+}
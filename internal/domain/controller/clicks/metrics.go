@@ -0,0 +1,23 @@
+package clicks
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	clicksRecorded = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "clicks_recorded_total",
+		Help: "Count all clicks saved by the click recorder.",
+	})
+
+	clicksFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "clicks_failed_total",
+		Help: "Count all clicks lost because of database errors.",
+	})
+
+	clicksDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "clicks_dropped_total",
+		Help: "Count all clicks dropped because the click buffer was full.",
+	})
+)
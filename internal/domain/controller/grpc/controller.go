@@ -2,24 +2,55 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
+	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
+	interceptorAuth "github.com/xgmsx/go-url-shortener-ddd/pkg/grpc/interceptors/auth"
+	interceptorRateLimit "github.com/xgmsx/go-url-shortener-ddd/pkg/grpc/interceptors/ratelimit"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
 	pb "github.com/xgmsx/go-url-shortener-ddd/proto/gen/shortener.v1"
 )
 
 type Controller struct {
 	pb.UnimplementedShortenerServer
 	createHandler *HandlerCreateLink
+	batchHandler  *HandlerCreateLinks
 	fetchHandler  *HandlerFetchLink
+	updateHandler *HandlerUpdateLink
+	deleteHandler *HandlerDeleteLink
+	listHandler   *HandlerListLinks
+	statsHandler  *HandlerLinkStats
+	ucAuth        auth.Usecase
 }
 
-func New(ucCreate create.Usecase, ucFetch fetch.Usecase) *Controller {
+func New(
+	ucCreate create.Usecase,
+	ucFetch fetch.Usecase,
+	ucUpdate update.Usecase,
+	ucDelete remove.Usecase,
+	ucList list.Usecase,
+	ucStats stats.Usecase,
+	ucAuth auth.Usecase,
+) *Controller {
 	return &Controller{
 		createHandler: NewHandlerCreateLink(ucCreate),
+		batchHandler:  NewHandlerCreateLinks(ucCreate),
 		fetchHandler:  NewHandlerFetchLink(ucFetch),
+		updateHandler: NewHandlerUpdateLink(ucUpdate),
+		deleteHandler: NewHandlerDeleteLink(ucDelete),
+		listHandler:   NewHandlerListLinks(ucList),
+		statsHandler:  NewHandlerLinkStats(ucStats),
+		ucAuth:        ucAuth,
 	}
 }
 
@@ -27,10 +58,69 @@ func (c *Controller) CreateLink(ctx context.Context, req *pb.CreateLinkRequest)
 	return c.createHandler.CreateLink(ctx, req)
 }
 
+func (c *Controller) CreateLinks(ctx context.Context, req *pb.CreateLinksRequest) (*pb.CreateLinksResponse, error) {
+	return c.batchHandler.CreateLinks(ctx, req)
+}
+
 func (c *Controller) FetchLink(ctx context.Context, req *pb.FetchLinkRequest) (*pb.FetchLinkResponse, error) {
 	return c.fetchHandler.FetchLink(ctx, req)
 }
 
+func (c *Controller) UpdateLink(ctx context.Context, req *pb.UpdateLinkRequest) (*pb.UpdateLinkResponse, error) {
+	return c.updateHandler.UpdateLink(ctx, req)
+}
+
+func (c *Controller) DeleteLink(ctx context.Context, req *pb.DeleteLinkRequest) (*pb.DeleteLinkResponse, error) {
+	return c.deleteHandler.DeleteLink(ctx, req)
+}
+
+func (c *Controller) ListLinks(ctx context.Context, req *pb.ListLinksRequest) (*pb.ListLinksResponse, error) {
+	return c.listHandler.ListLinks(ctx, req)
+}
+
+func (c *Controller) GetLinkStats(ctx context.Context, req *pb.GetLinkStatsRequest) (*pb.GetLinkStatsResponse, error) {
+	return c.statsHandler.GetLinkStats(ctx, req)
+}
+
+func (c *Controller) GetLinkTimeseries(ctx context.Context, req *pb.GetLinkTimeseriesRequest) (*pb.GetLinkTimeseriesResponse, error) {
+	return c.statsHandler.GetLinkTimeseries(ctx, req)
+}
+
 func (c *Controller) Register(server *grpc.Server) {
 	pb.RegisterShortenerServer(server, c)
 }
+
+// UnaryInterceptors returns the interceptors the server is created with,
+// all methods of the controller require an API key unless the authentication is disabled.
+func (c *Controller) UnaryInterceptors() []grpc.UnaryServerInterceptor {
+	if !c.ucAuth.Enabled() {
+		return nil
+	}
+
+	return []grpc.UnaryServerInterceptor{interceptorAuth.UnaryServerInterceptor(c.authenticate)}
+}
+
+// RateLimitKey counts the calls of the authenticated API key, anonymous calls per peer IP.
+// The rate limit interceptor must be chained after UnaryInterceptors.
+func RateLimitKey(ctx context.Context) string {
+	var principal string
+	if p, ok := entity.PrincipalFromContext(ctx); ok {
+		principal = p.KeyID.String()
+	}
+
+	return ratelimit.ClientKey(principal, interceptorRateLimit.PeerIP(ctx))
+}
+
+func (c *Controller) authenticate(ctx context.Context, key string) (context.Context, error) {
+	ctx, err := c.ucAuth.Authenticate(ctx, key)
+	if err != nil {
+		if errors.Is(err, entity.ErrUnauthorized) {
+			log.Warn().Err(err).Msg("uc.Authenticate: invalid api key")
+		} else {
+			log.Error().Err(err).Msg("uc.Authenticate: internal error")
+		}
+		return ctx, errorStatus(err)
+	}
+
+	return ctx, nil
+}
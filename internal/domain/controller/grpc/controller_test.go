@@ -2,10 +2,29 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"net"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth"
+	mocksAuth "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth/mocks"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/grpc"
 )
 
@@ -13,11 +32,99 @@ func TestController(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	ctrl := New(create.Usecase{}, fetch.Usecase{})
-	srv := grpc.New(ctrl)
+	ctrl := New(create.Usecase{}, fetch.Usecase{}, update.Usecase{}, remove.Usecase{}, list.Usecase{}, stats.Usecase{}, auth.Usecase{})
+	srv := grpc.New(&grpc.Options{UnaryInterceptors: ctrl.UnaryInterceptors()}, ctrl)
 	defer srv.Close()
 
 	go func() {
 		_ = srv.Serve(ctx, "9090")
 	}()
 }
+
+func TestControllerAuth(t *testing.T) {
+	owner := uuid.New()
+	key := entity.APIKey{ID: uuid.New(), OwnerID: owner}
+
+	testCases := []struct {
+		name      string
+		md        metadata.MD
+		wantCode  codes.Code
+		wantOwner uuid.UUID
+		setupMock func(database *mocksAuth.Mockdatabase)
+	}{
+		{
+			name:      "Happy path",
+			md:        metadata.Pairs("x-api-key", "sk_valid"),
+			wantCode:  codes.OK,
+			wantOwner: owner,
+			setupMock: func(database *mocksAuth.Mockdatabase) {
+				database.EXPECT().FindAPIKey(gomock.Any(), entity.HashAPIKey("sk_valid")).Return(&key, nil).Times(1)
+			},
+		},
+		{
+			name:     "Missing api key",
+			md:       metadata.MD{},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Invalid api key",
+			md:       metadata.Pairs("authorization", "Bearer sk_invalid"),
+			wantCode: codes.Unauthenticated,
+			setupMock: func(database *mocksAuth.Mockdatabase) {
+				database.EXPECT().FindAPIKey(gomock.Any(), entity.HashAPIKey("sk_invalid")).Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:     "Internal error",
+			md:       metadata.Pairs("x-api-key", "sk_valid"),
+			wantCode: codes.Internal,
+			setupMock: func(database *mocksAuth.Mockdatabase) {
+				database.EXPECT().FindAPIKey(gomock.Any(), gomock.Any()).Return(nil, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksAuth.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			c := New(create.Usecase{}, fetch.Usecase{}, update.Usecase{}, remove.Usecase{}, list.Usecase{}, stats.Usecase{}, auth.New(auth.Config{Enabled: true}, database))
+			interceptors := c.UnaryInterceptors()
+			require.Len(t, interceptors, 1)
+
+			var gotOwner uuid.UUID
+			handler := func(ctx context.Context, _ any) (any, error) {
+				gotOwner = entity.OwnerFromContext(ctx)
+				return nil, nil
+			}
+
+			// act
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+			_, err := interceptors[0](ctx, nil, &ggrpc.UnaryServerInfo{}, handler)
+
+			// assert
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			assert.Equal(t, tc.wantOwner, gotOwner)
+		})
+	}
+
+	c := New(create.Usecase{}, fetch.Usecase{}, update.Usecase{}, remove.Usecase{}, list.Usecase{}, stats.Usecase{}, auth.New(auth.Config{}, nil))
+	assert.Empty(t, c.UnaryInterceptors(), "the authentication is disabled")
+}
+
+func TestRateLimitKey(t *testing.T) {
+	keyID := uuid.New()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}})
+
+	assert.Equal(t, "ip:10.0.0.1", RateLimitKey(ctx))
+	assert.Equal(t, "ip:10.0.0.1", RateLimitKey(metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "sk_1"))),
+		"unverified keys are counted per peer IP")
+	assert.Equal(t, "key:"+keyID.String(), RateLimitKey(entity.WithPrincipal(ctx, entity.Principal{KeyID: keyID})))
+}
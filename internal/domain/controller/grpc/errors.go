@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// ErrorDomain is the domain reported in ErrorInfo details.
+const ErrorDomain = "shortener"
+
+// ErrorInfo reasons attached to gRPC errors.
+const (
+	ReasonValidation    = "VALIDATION_ERROR"
+	ReasonLinkNotFound  = "LINK_NOT_FOUND"
+	ReasonLinkExpired   = "LINK_EXPIRED"
+	ReasonAliasTaken    = "ALIAS_TAKEN"
+	ReasonAlreadyExists = "LINK_ALREADY_EXISTS"
+	ReasonURLBlocked    = "URL_BLOCKED"
+	ReasonInvalidAPIKey = "INVALID_API_KEY"
+	ReasonInternal      = "INTERNAL_ERROR"
+)
+
+// errorStatus converts domain errors to gRPC status errors with details.
+func errorStatus(err error) error {
+	var (
+		code    codes.Code
+		msg     string
+		details = []protoadapt.MessageV1{}
+	)
+
+	switch {
+	case errors.Is(err, entity.ErrInputValidation):
+		code, msg = codes.InvalidArgument, "validation error"
+		details = append(details, errorInfo(ReasonValidation))
+		var verr *entity.ValidationError
+		if errors.As(err, &verr) {
+			details = append(details, &errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{
+					{Field: verr.Field, Description: verr.Description},
+				},
+			})
+		}
+	case errors.Is(err, entity.ErrUnauthorized):
+		code, msg = codes.Unauthenticated, "invalid api key"
+		details = append(details, errorInfo(ReasonInvalidAPIKey))
+	case errors.Is(err, entity.ErrNotFound):
+		code, msg = codes.NotFound, "not found"
+		details = append(details, errorInfo(ReasonLinkNotFound))
+	case errors.Is(err, entity.ErrExpired):
+		code, msg = codes.FailedPrecondition, "link expired"
+		details = append(details, errorInfo(ReasonLinkExpired))
+	case errors.Is(err, entity.ErrBlocked):
+		code, msg = codes.PermissionDenied, "url is blocked"
+		details = append(details, errorInfo(ReasonURLBlocked))
+	case errors.Is(err, entity.ErrAliasTaken):
+		code, msg = codes.AlreadyExists, "alias already exists"
+		details = append(details, errorInfo(ReasonAliasTaken))
+	case errors.Is(err, entity.ErrAlreadyExist):
+		code, msg = codes.AlreadyExists, "already exists"
+		details = append(details, errorInfo(ReasonAlreadyExists))
+	default:
+		code, msg = codes.Internal, "internal error"
+		details = append(details, errorInfo(ReasonInternal))
+	}
+
+	st := status.New(code, msg)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// batchError returns the message and the ErrorInfo reason of the error of a link in a batch.
+func batchError(err error) (msg, reason string) {
+	if !errors.Is(err, entity.ErrInputValidation) && !errors.Is(err, entity.ErrBlocked) && !errors.Is(err, entity.ErrAliasTaken) {
+		log.Error().Err(err).Msg("uc.CreateLinks: internal error")
+	}
+
+	st := status.Convert(errorStatus(err))
+	msg = st.Message()
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			reason = d.GetReason()
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				msg += ": " + v.GetField() + " " + v.GetDescription()
+			}
+		}
+	}
+	return msg, reason
+}
+
+func errorInfo(reason string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain}
+}
@@ -3,13 +3,18 @@ package grpc
 import (
 	"context"
 	"errors"
-	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
@@ -29,32 +34,110 @@ func (h *HandlerCreateLink) CreateLink(ctx context.Context, req *pb.CreateLinkRe
 	ctx, span := tracer.Start(ctx, "grpc/v1 CreateLink")
 	defer span.End()
 
-	input := dto.CreateLinkInput{URL: req.GetUrl()}
+	input := createLinkInput(req)
 	if err := input.Validate(); err != nil {
 		log.Error().Err(err).Msg("uc.CreateLink: validate error")
-		return nil, fmt.Errorf("validation error")
+		return nil, errorStatus(err)
 	}
 
 	output, err := h.uc.Create(ctx, input)
 	if err != nil {
 		switch {
-		case errors.Is(err, entity.ErrAlreadyExist):
-			return &pb.CreateLinkResponse{
-				Url:       input.URL,
-				Alias:     output.Alias,
-				ExpiredAt: timestamppb.New(output.ExpiredAt),
-			}, nil
+		case errors.Is(err, entity.ErrAliasTaken) && input.Alias != "":
+			log.Error().Err(err).Msg("uc.CreateLink: alias already exists")
+			return nil, errorStatus(err)
+		case errors.Is(err, entity.ErrInputValidation):
+			log.Error().Err(err).Msg("uc.CreateLink: validation error")
+			return nil, errorStatus(err)
+		case errors.Is(err, entity.ErrBlocked):
+			log.Warn().Err(err).Msg("uc.CreateLink: url is blocked")
+			return nil, errorStatus(err)
+		case errors.Is(err, entity.ErrAlreadyExist) && !errors.Is(err, entity.ErrAliasTaken):
+			return createLinkResponse(output), nil
 		default:
 			log.Error().Err(err).Msg("uc.CreateLink: internal error")
-			return nil, fmt.Errorf("internal error")
+			return nil, errorStatus(err)
 		}
 	}
 
+	return createLinkResponse(output), nil
+}
+
+type HandlerCreateLinks struct {
+	uc create.Usecase
+}
+
+func NewHandlerCreateLinks(uc create.Usecase) *HandlerCreateLinks {
+	return &HandlerCreateLinks{uc: uc}
+}
+
+func (h *HandlerCreateLinks) CreateLinks(ctx context.Context, req *pb.CreateLinksRequest) (*pb.CreateLinksResponse, error) {
+	ctx, span := tracer.Start(ctx, "grpc/v1 CreateLinks")
+	defer span.End()
+
+	input := dto.CreateLinksInput{Links: make([]dto.CreateLinkInput, 0, len(req.GetLinks()))}
+	for _, link := range req.GetLinks() {
+		input.Links = append(input.Links, createLinkInput(link))
+	}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.CreateLinks: validate error")
+		return nil, errorStatus(err)
+	}
+
+	output, err := h.uc.CreateBatch(ctx, input)
+	if err != nil {
+		log.Error().Err(err).Msg("uc.CreateLinks: internal error")
+		return nil, errorStatus(err)
+	}
+
+	resp := &pb.CreateLinksResponse{
+		Created: int32(output.Created),
+		Failed:  int32(output.Failed),
+		Results: make([]*pb.CreateLinksResult, 0, len(output.Links)),
+	}
+	for _, item := range output.Links {
+		result := &pb.CreateLinksResult{Index: int32(item.Index), Existing: item.Existing}
+		if item.Link != nil {
+			result.Link = createLinkResponse(*item.Link)
+		}
+		if item.Err != nil {
+			result.Error, result.Reason = batchError(item.Err)
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+func createLinkInput(req *pb.CreateLinkRequest) dto.CreateLinkInput {
+	input := dto.CreateLinkInput{
+		URL:          req.GetUrl(),
+		Alias:        req.GetAlias(),
+		ExpiresIn:    req.GetExpiresIn(),
+		NeverExpires: req.GetNeverExpires(),
+		RedirectCode: int(req.GetRedirectCode()),
+		ForwardQuery: req.GetForwardQuery(),
+		Password:     req.GetPassword(),
+		MaxClicks:    int(req.GetMaxClicks()),
+	}
+	if req.GetExpiredAt() != nil {
+		expiredAt := req.GetExpiredAt().AsTime()
+		input.ExpiredAt = &expiredAt
+	}
+	return input
+}
+
+func createLinkResponse(output dto.CreateLinkOutput) *pb.CreateLinkResponse {
 	return &pb.CreateLinkResponse{
-		Url:       input.URL,
-		Alias:     output.Alias,
-		ExpiredAt: timestamppb.New(output.ExpiredAt),
-	}, nil
+		Url:               output.URL,
+		Alias:             output.Alias,
+		ShortUrl:          output.ShortURL,
+		ExpiredAt:         timestamp(output.ExpiredAt),
+		RedirectCode:      int32(output.RedirectCode),
+		ForwardQuery:      output.ForwardQuery,
+		PasswordProtected: output.PasswordProtected,
+		MaxClicks:         int32(output.MaxClicks),
+	}
 }
 
 type HandlerFetchLink struct {
@@ -72,7 +155,7 @@ func (h *HandlerFetchLink) FetchLink(ctx context.Context, req *pb.FetchLinkReque
 	input := dto.FetchLinkInput{Alias: req.GetAlias()}
 	if err := input.Validate(); err != nil {
 		log.Error().Err(err).Msg("uc.FetchLink: validate error")
-		return nil, fmt.Errorf("validation error")
+		return nil, errorStatus(err)
 	}
 
 	output, err := h.uc.Fetch(ctx, input)
@@ -80,16 +163,283 @@ func (h *HandlerFetchLink) FetchLink(ctx context.Context, req *pb.FetchLinkReque
 		switch {
 		case errors.Is(err, entity.ErrNotFound):
 			log.Error().Err(err).Msg("uc.FetchLink: not found")
-			return nil, fmt.Errorf("not found")
+			return nil, errorStatus(err)
+		case errors.Is(err, entity.ErrExpired):
+			log.Error().Err(err).Msg("uc.FetchLink: expired")
+			return nil, errorStatus(err)
 		default:
 			log.Error().Err(err).Msg("uc.FetchLink: internal error")
-			return nil, fmt.Errorf("internal error")
+			return nil, errorStatus(err)
 		}
 	}
 
 	return &pb.FetchLinkResponse{
-		Url:       output.URL,
-		Alias:     output.Alias,
-		ExpiredAt: timestamppb.New(output.ExpiredAt),
+		Url:               output.URL,
+		Alias:             output.Alias,
+		ShortUrl:          output.ShortURL,
+		ExpiredAt:         timestamp(output.ExpiredAt),
+		RedirectCode:      int32(output.RedirectCode),
+		ForwardQuery:      output.ForwardQuery,
+		PasswordProtected: output.PasswordProtected,
+		MaxClicks:         int32(output.MaxClicks),
 	}, nil
 }
+
+type HandlerUpdateLink struct {
+	uc update.Usecase
+}
+
+func NewHandlerUpdateLink(uc update.Usecase) *HandlerUpdateLink {
+	return &HandlerUpdateLink{uc: uc}
+}
+
+func (h *HandlerUpdateLink) UpdateLink(ctx context.Context, req *pb.UpdateLinkRequest) (*pb.UpdateLinkResponse, error) {
+	ctx, span := tracer.Start(ctx, "grpc/v1 UpdateLink")
+	defer span.End()
+
+	input := dto.UpdateLinkInput{
+		Alias:        req.GetAlias(),
+		URL:          req.GetUrl(),
+		ExpiresIn:    req.GetExpiresIn(),
+		NeverExpires: req.GetNeverExpires(),
+	}
+	if req.GetExpiredAt() != nil {
+		expiredAt := req.GetExpiredAt().AsTime()
+		input.ExpiredAt = &expiredAt
+	}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.UpdateLink: validate error")
+		return nil, errorStatus(err)
+	}
+
+	output, err := h.uc.Update(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInputValidation):
+			log.Error().Err(err).Msg("uc.UpdateLink: validation error")
+		case errors.Is(err, entity.ErrBlocked):
+			log.Warn().Err(err).Msg("uc.UpdateLink: url is blocked")
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.UpdateLink: not found")
+		default:
+			log.Error().Err(err).Msg("uc.UpdateLink: internal error")
+		}
+		return nil, errorStatus(err)
+	}
+
+	return &pb.UpdateLinkResponse{
+		Url:               output.URL,
+		Alias:             output.Alias,
+		ShortUrl:          output.ShortURL,
+		ExpiredAt:         timestamp(output.ExpiredAt),
+		RedirectCode:      int32(output.RedirectCode),
+		ForwardQuery:      output.ForwardQuery,
+		PasswordProtected: output.PasswordProtected,
+		MaxClicks:         int32(output.MaxClicks),
+	}, nil
+}
+
+type HandlerDeleteLink struct {
+	uc remove.Usecase
+}
+
+func NewHandlerDeleteLink(uc remove.Usecase) *HandlerDeleteLink {
+	return &HandlerDeleteLink{uc: uc}
+}
+
+func (h *HandlerDeleteLink) DeleteLink(ctx context.Context, req *pb.DeleteLinkRequest) (*pb.DeleteLinkResponse, error) {
+	ctx, span := tracer.Start(ctx, "grpc/v1 DeleteLink")
+	defer span.End()
+
+	input := dto.DeleteLinkInput{Alias: req.GetAlias()}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.DeleteLink: validate error")
+		return nil, errorStatus(err)
+	}
+
+	err := h.uc.Delete(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.DeleteLink: not found")
+		default:
+			log.Error().Err(err).Msg("uc.DeleteLink: internal error")
+		}
+		return nil, errorStatus(err)
+	}
+
+	return &pb.DeleteLinkResponse{}, nil
+}
+
+type HandlerListLinks struct {
+	uc list.Usecase
+}
+
+func NewHandlerListLinks(uc list.Usecase) *HandlerListLinks {
+	return &HandlerListLinks{uc: uc}
+}
+
+func (h *HandlerListLinks) ListLinks(ctx context.Context, req *pb.ListLinksRequest) (*pb.ListLinksResponse, error) {
+	ctx, span := tracer.Start(ctx, "grpc/v1 ListLinks")
+	defer span.End()
+
+	input := dto.ListLinksInput{
+		State:  req.GetState(),
+		Domain: req.GetDomain(),
+		Sort:   req.GetSort(),
+		Order:  req.GetOrder(),
+		Cursor: req.GetCursor(),
+		Limit:  int(req.GetLimit()),
+	}
+	if req.GetOwnerId() != "" {
+		ownerID, err := uuid.Parse(req.GetOwnerId())
+		if err != nil {
+			log.Error().Err(err).Msg("uc.ListLinks: validate error")
+			return nil, errorStatus(entity.NewValidationError("owner_id", "must be a valid UUID"))
+		}
+		input.OwnerID = ownerID
+	}
+	if req.GetCreatedFrom() != nil {
+		input.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+	if req.GetCreatedTo() != nil {
+		input.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.ListLinks: validate error")
+		return nil, errorStatus(err)
+	}
+
+	output, err := h.uc.List(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInputValidation):
+			log.Error().Err(err).Msg("uc.ListLinks: validation error")
+		default:
+			log.Error().Err(err).Msg("uc.ListLinks: internal error")
+		}
+		return nil, errorStatus(err)
+	}
+
+	links := make([]*pb.ListLinksItem, 0, len(output.Links))
+	for _, l := range output.Links {
+		links = append(links, &pb.ListLinksItem{
+			Url:               l.URL,
+			Alias:             l.Alias,
+			ShortUrl:          l.ShortURL,
+			ExpiredAt:         timestamp(l.ExpiredAt),
+			RedirectCode:      int32(l.RedirectCode),
+			ForwardQuery:      l.ForwardQuery,
+			PasswordProtected: l.PasswordProtected,
+			MaxClicks:         int32(l.MaxClicks),
+			CreatedAt:         timestamppb.New(l.CreatedAt),
+		})
+	}
+
+	return &pb.ListLinksResponse{Links: links, NextCursor: output.NextCursor}, nil
+}
+
+type HandlerLinkStats struct {
+	uc stats.Usecase
+}
+
+func NewHandlerLinkStats(uc stats.Usecase) *HandlerLinkStats {
+	return &HandlerLinkStats{uc: uc}
+}
+
+func (h *HandlerLinkStats) GetLinkStats(ctx context.Context, req *pb.GetLinkStatsRequest) (*pb.GetLinkStatsResponse, error) {
+	ctx, span := tracer.Start(ctx, "grpc/v1 GetLinkStats")
+	defer span.End()
+
+	input := dto.LinkStatsInput{Alias: req.GetAlias(), Top: int(req.GetTop())}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.LinkStats: validate error")
+		return nil, errorStatus(err)
+	}
+
+	output, err := h.uc.Stats(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.LinkStats: not found")
+		default:
+			log.Error().Err(err).Msg("uc.LinkStats: internal error")
+		}
+		return nil, errorStatus(err)
+	}
+
+	return &pb.GetLinkStatsResponse{
+		Alias:        output.Alias,
+		TotalClicks:  output.TotalClicks,
+		FirstClickAt: timestamp(output.FirstClickAt),
+		LastClickAt:  timestamp(output.LastClickAt),
+		ClicksDay:    output.ClicksDay,
+		ClicksWeek:   output.ClicksWeek,
+		ClicksMonth:  output.ClicksMonth,
+		Countries:    statsEntries(output.Countries),
+		Referrers:    statsEntries(output.Referrers),
+		Browsers:     statsEntries(output.Browsers),
+		Os:           statsEntries(output.OS),
+		Devices:      statsEntries(output.Devices),
+	}, nil
+}
+
+func (h *HandlerLinkStats) GetLinkTimeseries(ctx context.Context, req *pb.GetLinkTimeseriesRequest) (*pb.GetLinkTimeseriesResponse, error) {
+	ctx, span := tracer.Start(ctx, "grpc/v1 GetLinkTimeseries")
+	defer span.End()
+
+	input := dto.LinkTimeseriesInput{
+		Alias:    req.GetAlias(),
+		Interval: req.GetInterval(),
+		TimeZone: req.GetTz(),
+	}
+	if req.GetFrom() != nil {
+		input.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		input.To = req.GetTo().AsTime()
+	}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.LinkTimeseries: validate error")
+		return nil, errorStatus(err)
+	}
+
+	output, err := h.uc.Timeseries(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.LinkTimeseries: not found")
+		default:
+			log.Error().Err(err).Msg("uc.LinkTimeseries: internal error")
+		}
+		return nil, errorStatus(err)
+	}
+
+	buckets := make([]*pb.TimeseriesBucket, 0, len(output.Buckets))
+	for _, b := range output.Buckets {
+		buckets = append(buckets, &pb.TimeseriesBucket{Start: timestamppb.New(b.Start), Clicks: b.Clicks})
+	}
+
+	return &pb.GetLinkTimeseriesResponse{
+		Alias:    output.Alias,
+		Interval: output.Interval,
+		Tz:       output.TimeZone,
+		Buckets:  buckets,
+	}, nil
+}
+
+func statsEntries(entries []dto.StatsEntry) []*pb.StatsEntry {
+	res := make([]*pb.StatsEntry, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, &pb.StatsEntry{Value: e.Value, Clicks: e.Clicks})
+	}
+	return res
+}
+
+// timestamp returns nil for unset times, e.g. for links that never expire.
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -6,70 +6,147 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	adapterAlias "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/alias"
+	adapterBlocklist "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/blocklist"
+	adapterShortURL "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/shorturl"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/grpc"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	mocksCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create/mocks"
 	ucFetch "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
 	mocksFetch "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch/mocks"
+	ucList "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	mocksList "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list/mocks"
+	ucDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	mocksDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove/mocks"
+	ucStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	mocksStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats/mocks"
+	ucUpdate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
+	mocksUpdate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update/mocks"
 	pb "github.com/xgmsx/go-url-shortener-ddd/proto/gen/shortener.v1"
 
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+var shortURL, _ = adapterShortURL.New(adapterShortURL.Config{BaseURL: "https://sho.rt"})
+
+var blocklist, _ = adapterBlocklist.New(adapterBlocklist.Config{File: "testdata/blocklist.txt"})
+
 func TestCreateLink(t *testing.T) {
-	initMock := func(t *testing.T) (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache, *mocksCreate.Mockpublisher) {
+	initMock := func(t *testing.T) (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache) {
 		ctrl := gomock.NewController(t)
 		database := mocksCreate.NewMockdatabase(ctrl)
 		cache := mocksCreate.NewMockcache(ctrl)
-		publisher := mocksCreate.NewMockpublisher(ctrl)
-		return ctrl, database, cache, publisher
+		return ctrl, database, cache
 	}
 
 	testCases := []struct {
 		name       string
+		config     create.Config
 		input      *pb.CreateLinkRequest
 		wantStatus codes.Code
 		wantOutput *pb.CreateLinkResponse
 		wantError  string
-		setupMock  func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher)
+		wantReason string
+		setupMock  func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache)
 	}{
 		{
 			name:       "Happy path",
 			input:      &pb.CreateLinkRequest{Url: "https://example.com"},
 			wantStatus: codes.OK,
-			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-				publisher.EXPECT().SendLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
 			name:       "Link already exists",
 			input:      &pb.CreateLinkRequest{Url: "https://example.com"},
 			wantStatus: codes.OK,
-			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAlreadyExist).Times(1)
 			},
 		},
+		{
+			name:       "Happy path with never expiring link",
+			input:      &pb.CreateLinkRequest{Url: "https://example.com", Alias: "promo-2026", NeverExpires: true},
+			wantStatus: codes.OK,
+			wantOutput: &pb.CreateLinkResponse{Url: "https://example.com", Alias: "promo-2026", ShortUrl: "https://sho.rt/promo-2026", ExpiredAt: nil, RedirectCode: 302},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with redirect options",
+			input:      &pb.CreateLinkRequest{Url: "https://example.com", Alias: "promo-2026", NeverExpires: true, RedirectCode: 307, ForwardQuery: true},
+			wantStatus: codes.OK,
+			wantOutput: &pb.CreateLinkResponse{
+				Url: "https://example.com", Alias: "promo-2026", ShortUrl: "https://sho.rt/promo-2026", RedirectCode: 307, ForwardQuery: true,
+			},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Dedup: already shortened URL",
+			config:     create.Config{Dedup: true},
+			input:      &pb.CreateLinkRequest{Url: "https://example.com"},
+			wantStatus: codes.OK,
+			wantOutput: &pb.CreateLinkResponse{Url: "https://example.com", Alias: "existing", ShortUrl: "https://sho.rt/existing", ExpiredAt: nil, RedirectCode: 302},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				existing := entity.Link{URL: "https://example.com", Alias: "existing"}
+				database.EXPECT().FindOrCreateLink(gomock.Any(), gomock.Any()).Return(existing, entity.ErrAlreadyExist).Times(1)
+			},
+		},
+		{
+			name:       "URL is blocked",
+			input:      &pb.CreateLinkRequest{Url: "https://login.evil.com/account"},
+			wantStatus: codes.PermissionDenied,
+			wantError:  `url is blocked`,
+			wantReason: grpc.ReasonURLBlocked,
+		},
+		{
+			name:       "Custom alias already taken",
+			input:      &pb.CreateLinkRequest{Url: "https://example.com", Alias: "promo-2026"},
+			wantStatus: codes.AlreadyExists,
+			wantError:  "alias already exists",
+			wantReason: grpc.ReasonAliasTaken,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAliasTaken).Times(1)
+			},
+		},
 		{
 			name:       "Validation error",
 			input:      &pb.CreateLinkRequest{Url: ""},
-			wantStatus: codes.OK,
+			wantStatus: codes.InvalidArgument,
 			wantError:  `validation error`,
+			wantReason: grpc.ReasonValidation,
+		},
+		{
+			name:       "Validation error: reserved alias",
+			input:      &pb.CreateLinkRequest{Url: "https://example.com", Alias: "Metrics"},
+			wantStatus: codes.InvalidArgument,
+			wantError:  `validation error`,
+			wantReason: grpc.ReasonValidation,
 		},
 		{
 			name:       "Internal error",
 			input:      &pb.CreateLinkRequest{Url: "https://example.com"},
-			wantStatus: codes.OK,
+			wantStatus: codes.Internal,
 			wantError:  "internal error",
-			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			wantReason: grpc.ReasonInternal,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(errors.New("test error")).Times(1)
 			},
 		},
@@ -77,15 +154,15 @@ func TestCreateLink(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			ctrl, database, cache, publisher := initMock(t)
+			ctrl, database, cache := initMock(t)
 			defer ctrl.Finish()
 
 			if tc.setupMock != nil {
-				tc.setupMock(database, cache, publisher)
+				tc.setupMock(database, cache)
 			}
 
 			// arrange
-			uc := create.New(database, cache, publisher)
+			uc := create.New(tc.config, database, cache, adapterAlias.NewUUID(), shortURL, blocklist)
 			handler := grpc.NewHandlerCreateLink(uc)
 
 			// act
@@ -96,12 +173,16 @@ func TestCreateLink(t *testing.T) {
 				assert.Nil(t, resp)
 				st, _ := status.FromError(err)
 				assert.Equal(t, tc.wantStatus, st.Code())
+				assert.Equal(t, tc.wantReason, errorReason(st))
 			}
 
 			if tc.wantOutput != nil {
 				require.NoError(t, err)
 				assert.Equal(t, tc.wantOutput.Url, resp.Url)
 				assert.Equal(t, tc.wantOutput.Alias, resp.Alias)
+				assert.Equal(t, tc.wantOutput.ShortUrl, resp.ShortUrl)
+				assert.Equal(t, tc.wantOutput.RedirectCode, resp.RedirectCode)
+				assert.Equal(t, tc.wantOutput.ForwardQuery, resp.ForwardQuery)
 				assert.Equal(t, tc.wantOutput.ExpiredAt.AsTime().Truncate(time.Second), resp.ExpiredAt.AsTime().Truncate(time.Second))
 			}
 
@@ -113,6 +194,117 @@ func TestCreateLink(t *testing.T) {
 	}
 }
 
+func TestCreateLinks(t *testing.T) {
+	initMock := func(t *testing.T) (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksCreate.NewMockdatabase(ctrl)
+		cache := mocksCreate.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	type result struct {
+		alias  string
+		error  string
+		reason string
+	}
+
+	testCases := []struct {
+		name        string
+		input       *pb.CreateLinksRequest
+		wantStatus  codes.Code
+		wantCreated int32
+		wantResults []result
+		setupMock   func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache)
+	}{
+		{
+			name: "Happy path",
+			input: &pb.CreateLinksRequest{Links: []*pb.CreateLinkRequest{
+				{Url: "https://example.com", Alias: "promo-2026"},
+				{Url: "https://example.org", Alias: "promo-2027"},
+			}},
+			wantStatus:  codes.OK,
+			wantCreated: 2,
+			wantResults: []result{{alias: "promo-2026"}, {alias: "promo-2027"}},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Len(2)).Return([]bool{true, true}, nil).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Failed links are reported",
+			input: &pb.CreateLinksRequest{Links: []*pb.CreateLinkRequest{
+				{Url: "https://example.com", Alias: "a"},
+				{Url: "https://evil.com"},
+				{Url: "https://example.com", Alias: "promo-2026"},
+				{Url: "https://example.org", Alias: "promo-2027"},
+			}},
+			wantStatus:  codes.OK,
+			wantCreated: 1,
+			wantResults: []result{
+				{error: "validation error: alias must be 2-64 characters long and contain only letters, digits, '_' and '-'", reason: grpc.ReasonValidation},
+				{error: "url is blocked", reason: grpc.ReasonURLBlocked},
+				{error: "alias already exists", reason: grpc.ReasonAliasTaken},
+				{alias: "promo-2027"},
+			},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Len(2)).Return([]bool{false, true}, nil).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Empty batch",
+			input:      &pb.CreateLinksRequest{},
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name:       "Database error",
+			input:      &pb.CreateLinksRequest{Links: []*pb.CreateLinkRequest{{Url: "https://example.com"}}},
+			wantStatus: codes.Internal,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Any()).Return(nil, errors.New("test error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock(t)
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			uc := create.New(create.Config{}, database, cache, adapterAlias.NewUUID(), shortURL, blocklist)
+			handler := grpc.NewHandlerCreateLinks(uc)
+
+			// act
+			resp, err := handler.CreateLinks(context.Background(), tc.input)
+
+			// assert
+			if tc.wantStatus != codes.OK {
+				assert.Nil(t, resp)
+				st, _ := status.FromError(err)
+				assert.Equal(t, tc.wantStatus, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCreated, resp.GetCreated())
+			assert.Equal(t, int32(len(tc.wantResults))-tc.wantCreated, resp.GetFailed())
+			require.Len(t, resp.GetResults(), len(tc.wantResults))
+			for i, want := range tc.wantResults {
+				got := resp.GetResults()[i]
+				assert.Equal(t, int32(i), got.GetIndex())
+				assert.Equal(t, want.alias, got.GetLink().GetAlias())
+				assert.Equal(t, want.error, got.GetError())
+				assert.Equal(t, want.reason, got.GetReason())
+			}
+		})
+	}
+}
+
 func TestFetchLink(t *testing.T) {
 	initMock := func() (*gomock.Controller, *mocksFetch.Mockdatabase, *mocksFetch.Mockcache) {
 		ctrl := gomock.NewController(t)
@@ -127,6 +319,7 @@ func TestFetchLink(t *testing.T) {
 		wantStatus codes.Code
 		wantOutput *pb.FetchLinkResponse
 		wantError  string
+		wantReason string
 		setupMock  func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache)
 	}{
 		{
@@ -134,32 +327,57 @@ func TestFetchLink(t *testing.T) {
 			input:      &pb.FetchLinkRequest{Alias: "alias1"},
 			wantStatus: codes.OK,
 			wantOutput: &pb.FetchLinkResponse{
-				Url: "https://example.com", Alias: "alias1", ExpiredAt: timestamppb.New(time.Time{}),
+				Url: "https://example.com", Alias: "alias1", ExpiredAt: timestamppb.New(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
 			},
 			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				link := entity.Link{URL: "https://example.com", Alias: "alias1", ExpiredAt: time.Time{}}
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", ExpiredAt: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}
 				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(nil, entity.ErrNotFound).Times(1)
 				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
 				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
 			},
 		},
 		{
-			name:       "Happy path with cached link",
+			name:       "Happy path with cached never expiring link",
 			input:      &pb.FetchLinkRequest{Alias: "alias2"},
 			wantStatus: codes.OK,
 			wantOutput: &pb.FetchLinkResponse{
-				Url: "https://example.com", Alias: "alias2", ExpiredAt: timestamppb.New(time.Time{}),
+				Url: "https://example.com", Alias: "alias2", ExpiredAt: nil,
 			},
 			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
 				cachedLink := entity.Link{URL: "https://example.com", Alias: "alias2", ExpiredAt: time.Time{}}
 				cache.EXPECT().GetLink(gomock.Any(), "alias2").Return(&cachedLink, nil).Times(1)
 			},
 		},
+		{
+			name:       "Protected link hides the URL",
+			input:      &pb.FetchLinkRequest{Alias: "alias6"},
+			wantStatus: codes.OK,
+			wantOutput: &pb.FetchLinkResponse{
+				Url: "", Alias: "alias6", PasswordProtected: true,
+			},
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cachedLink := entity.Link{URL: "https://example.com", Alias: "alias6", PasswordHash: "hash"}
+				cache.EXPECT().GetLink(gomock.Any(), "alias6").Return(&cachedLink, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link expired",
+			input:      &pb.FetchLinkRequest{Alias: "alias5"},
+			wantStatus: codes.FailedPrecondition,
+			wantError:  `link expired`,
+			wantReason: grpc.ReasonLinkExpired,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias5", ExpiredAt: time.Now().Add(-time.Minute)}
+				cache.EXPECT().GetLink(gomock.Any(), "alias5").Return(nil, entity.ErrNotFound).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "alias5", "").Return(&link, nil).Times(1)
+			},
+		},
 		{
 			name:       "Link not found",
 			input:      &pb.FetchLinkRequest{Alias: "unknown"},
-			wantStatus: codes.OK,
+			wantStatus: codes.NotFound,
 			wantError:  `not found`,
+			wantReason: grpc.ReasonLinkNotFound,
 			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
 				cache.EXPECT().GetLink(gomock.Any(), "unknown").Return(nil, entity.ErrNotFound).Times(1)
 				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
@@ -168,14 +386,16 @@ func TestFetchLink(t *testing.T) {
 		{
 			name:       "Validation error",
 			input:      &pb.FetchLinkRequest{Alias: "a"},
-			wantStatus: codes.OK,
+			wantStatus: codes.InvalidArgument,
 			wantError:  `validation error`,
+			wantReason: grpc.ReasonValidation,
 		},
 		{
 			name:       "Internal error",
 			input:      &pb.FetchLinkRequest{Alias: "alias3"},
-			wantStatus: codes.OK,
+			wantStatus: codes.Internal,
 			wantError:  `internal error`,
+			wantReason: grpc.ReasonInternal,
 			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
 				cache.EXPECT().GetLink(gomock.Any(), "alias3").Return(nil, errors.New("test cache error")).Times(1)
 				database.EXPECT().FindLink(gomock.Any(), "alias3", "").Return(nil, errors.New("test db error")).Times(1)
@@ -193,7 +413,7 @@ func TestFetchLink(t *testing.T) {
 			}
 
 			/// arrange
-			uc := ucFetch.New(database, cache)
+			uc := ucFetch.New(ucFetch.Config{}, database, cache, shortURL)
 			handler := grpc.NewHandlerFetchLink(uc)
 
 			// act
@@ -204,6 +424,7 @@ func TestFetchLink(t *testing.T) {
 				assert.Nil(t, resp)
 				st, _ := status.FromError(err)
 				assert.Equal(t, tc.wantStatus, st.Code())
+				assert.Equal(t, tc.wantReason, errorReason(st))
 			}
 
 			if tc.wantOutput != nil {
@@ -211,6 +432,7 @@ func TestFetchLink(t *testing.T) {
 				assert.Equal(t, tc.wantOutput.Url, resp.Url)
 				assert.Equal(t, tc.wantOutput.Alias, resp.Alias)
 				assert.Equal(t, tc.wantOutput.ExpiredAt.AsTime().Truncate(time.Second), resp.ExpiredAt.AsTime().Truncate(time.Second))
+				assert.Equal(t, tc.wantOutput.PasswordProtected, resp.PasswordProtected)
 			}
 
 			if tc.wantError != "" {
@@ -220,3 +442,513 @@ func TestFetchLink(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateLink(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksUpdate.Mockdatabase, *mocksUpdate.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksUpdate.NewMockdatabase(ctrl)
+		cache := mocksUpdate.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	testCases := []struct {
+		name       string
+		input      *pb.UpdateLinkRequest
+		wantStatus codes.Code
+		wantOutput *pb.UpdateLinkResponse
+		wantReason string
+		setupMock  func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache)
+	}{
+		{
+			name:       "Happy path",
+			input:      &pb.UpdateLinkRequest{Alias: "alias1", Url: "https://example.org", ExpiresIn: 3600},
+			wantStatus: codes.OK,
+			wantOutput: &pb.UpdateLinkResponse{Url: "https://example.org", Alias: "alias1", ExpiredAt: timestamppb.New(time.Now().Add(time.Hour))},
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				changes := gomock.Cond(func(c entity.LinkChanges) bool {
+					return c.URL == "https://example.org" && c.SetExpiry && time.Until(c.ExpiredAt) > 59*time.Minute
+				})
+				updated := entity.Link{URL: "https://example.org", Alias: "alias1", ExpiredAt: time.Now().Add(time.Hour)}
+				database.EXPECT().UpdateLink(gomock.Any(), "alias1", changes, uuid.Nil).Return(updated, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			input:      &pb.UpdateLinkRequest{Alias: "unknown", Url: "https://example.org"},
+			wantStatus: codes.NotFound,
+			wantReason: grpc.ReasonLinkNotFound,
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				database.EXPECT().UpdateLink(gomock.Any(), "unknown", gomock.Any(), uuid.Nil).Return(entity.Link{}, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			input:      &pb.UpdateLinkRequest{Alias: "alias1"},
+			wantStatus: codes.InvalidArgument,
+			wantReason: grpc.ReasonValidation,
+		},
+		{
+			name:       "Internal error",
+			input:      &pb.UpdateLinkRequest{Alias: "alias2", Url: "https://example.org"},
+			wantStatus: codes.Internal,
+			wantReason: grpc.ReasonInternal,
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				database.EXPECT().UpdateLink(gomock.Any(), "alias2", gomock.Any(), uuid.Nil).Return(entity.Link{}, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			handler := grpc.NewHandlerUpdateLink(ucUpdate.New(entity.Lifetime{}, database, cache, shortURL, blocklist))
+
+			// act
+			resp, err := handler.UpdateLink(context.Background(), tc.input)
+
+			// assert
+			st, _ := status.FromError(err)
+			assert.Equal(t, tc.wantStatus, st.Code())
+			if tc.wantOutput != nil {
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantOutput.Url, resp.Url)
+				assert.Equal(t, tc.wantOutput.Alias, resp.Alias)
+				assert.WithinDuration(t, tc.wantOutput.ExpiredAt.AsTime(), resp.ExpiredAt.AsTime(), time.Second)
+			}
+			if tc.wantStatus != codes.OK {
+				assert.Nil(t, resp)
+				assert.Equal(t, tc.wantReason, errorReason(st))
+			}
+		})
+	}
+}
+
+func TestDeleteLink(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksDelete.Mockdatabase, *mocksDelete.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksDelete.NewMockdatabase(ctrl)
+		cache := mocksDelete.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	testCases := []struct {
+		name       string
+		input      *pb.DeleteLinkRequest
+		wantStatus codes.Code
+		wantReason string
+		setupMock  func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache)
+	}{
+		{
+			name:       "Happy path",
+			input:      &pb.DeleteLinkRequest{Alias: "alias1"},
+			wantStatus: codes.OK,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1"}
+				database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).Return(link, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			input:      &pb.DeleteLinkRequest{Alias: "unknown"},
+			wantStatus: codes.NotFound,
+			wantReason: grpc.ReasonLinkNotFound,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				database.EXPECT().DeleteLink(gomock.Any(), "unknown", uuid.Nil).Return(entity.Link{}, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			input:      &pb.DeleteLinkRequest{Alias: "a"},
+			wantStatus: codes.InvalidArgument,
+			wantReason: grpc.ReasonValidation,
+		},
+		{
+			name:       "Internal error",
+			input:      &pb.DeleteLinkRequest{Alias: "alias2"},
+			wantStatus: codes.Internal,
+			wantReason: grpc.ReasonInternal,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				database.EXPECT().DeleteLink(gomock.Any(), "alias2", uuid.Nil).Return(entity.Link{}, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			handler := grpc.NewHandlerDeleteLink(ucDelete.New(database, cache))
+
+			// act
+			resp, err := handler.DeleteLink(context.Background(), tc.input)
+
+			// assert
+			st, _ := status.FromError(err)
+			assert.Equal(t, tc.wantStatus, st.Code())
+			if tc.wantStatus == codes.OK {
+				require.NoError(t, err)
+				assert.NotNil(t, resp)
+			} else {
+				assert.Nil(t, resp)
+				assert.Equal(t, tc.wantReason, errorReason(st))
+			}
+		})
+	}
+}
+
+func TestGetLinkStats(t *testing.T) {
+	owner := uuid.New()
+	lastClickAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context // nil - internal caller
+		input      *pb.GetLinkStatsRequest
+		wantStatus codes.Code
+		wantOutput *pb.GetLinkStatsResponse
+		wantReason string
+		setupMock  func(database *mocksStats.Mockdatabase)
+	}{
+		{
+			name:       "Happy path",
+			input:      &pb.GetLinkStatsRequest{Alias: "alias1"},
+			wantStatus: codes.OK,
+			wantOutput: &pb.GetLinkStatsResponse{
+				Alias: "alias1", TotalClicks: 2, FirstClickAt: timestamppb.New(lastClickAt), LastClickAt: timestamppb.New(lastClickAt),
+				ClicksDay: 1, ClicksWeek: 2, ClicksMonth: 2,
+			},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias1"}
+				stats := entity.LinkStats{TotalClicks: 2, FirstClickAt: lastClickAt, LastClickAt: lastClickAt, ClicksDay: 1, ClicksWeek: 2, ClicksMonth: 2}
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetLinkStats(gomock.Any(), link.ID, gomock.Any(), 10).Return(stats, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			input:      &pb.GetLinkStatsRequest{Alias: "unknown"},
+			wantStatus: codes.NotFound,
+			wantReason: grpc.ReasonLinkNotFound,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Link of another owner",
+			ctx:        entity.WithPrincipal(context.Background(), entity.Principal{OwnerID: owner}),
+			input:      &pb.GetLinkStatsRequest{Alias: "alias1"},
+			wantStatus: codes.NotFound,
+			wantReason: grpc.ReasonLinkNotFound,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias1", OwnerID: uuid.New()}
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link without owner",
+			ctx:        entity.WithPrincipal(context.Background(), entity.Principal{OwnerID: owner}),
+			input:      &pb.GetLinkStatsRequest{Alias: "alias1"},
+			wantStatus: codes.NotFound,
+			wantReason: grpc.ReasonLinkNotFound,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&entity.Link{Alias: "alias1"}, nil).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			input:      &pb.GetLinkStatsRequest{Alias: "a"},
+			wantStatus: codes.InvalidArgument,
+			wantReason: grpc.ReasonValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksStats.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			handler := grpc.NewHandlerLinkStats(ucStats.New(database, nil, nil))
+
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			// act
+			resp, err := handler.GetLinkStats(ctx, tc.input)
+
+			// assert
+			st, _ := status.FromError(err)
+			assert.Equal(t, tc.wantStatus, st.Code())
+			if tc.wantOutput != nil {
+				require.NoError(t, err)
+				assert.True(t, proto.Equal(tc.wantOutput, resp))
+			}
+			if tc.wantStatus != codes.OK {
+				assert.Nil(t, resp)
+				assert.Equal(t, tc.wantReason, errorReason(st))
+			}
+		})
+	}
+}
+
+func TestGetLinkTimeseries(t *testing.T) {
+	owner := uuid.New()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context // nil - internal caller
+		input      *pb.GetLinkTimeseriesRequest
+		wantStatus codes.Code
+		wantOutput *pb.GetLinkTimeseriesResponse
+		wantReason string
+		setupMock  func(database *mocksStats.Mockdatabase)
+	}{
+		{
+			name:       "Happy path",
+			input:      &pb.GetLinkTimeseriesRequest{Alias: "alias1", From: timestamppb.New(from), To: timestamppb.New(to), Interval: "hour"},
+			wantStatus: codes.OK,
+			wantOutput: &pb.GetLinkTimeseriesResponse{
+				Alias: "alias1", Interval: "hour", Tz: "UTC",
+				Buckets: []*pb.TimeseriesBucket{
+					{Start: timestamppb.New(from), Clicks: 0},
+					{Start: timestamppb.New(from.Add(time.Hour)), Clicks: 7},
+					{Start: timestamppb.New(from.Add(2 * time.Hour)), Clicks: 0},
+				},
+			},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias1"}
+				hourly := []entity.ClickBucket{{Start: from.Add(time.Hour), Clicks: 7}}
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetClickHistogram(gomock.Any(), link.ID, from, to).Return(hourly, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			input:      &pb.GetLinkTimeseriesRequest{Alias: "unknown", From: timestamppb.New(from), To: timestamppb.New(to), Interval: "day"},
+			wantStatus: codes.NotFound,
+			wantReason: grpc.ReasonLinkNotFound,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Link of another owner",
+			ctx:        entity.WithPrincipal(context.Background(), entity.Principal{OwnerID: owner}),
+			input:      &pb.GetLinkTimeseriesRequest{Alias: "alias1", From: timestamppb.New(from), To: timestamppb.New(to), Interval: "day"},
+			wantStatus: codes.NotFound,
+			wantReason: grpc.ReasonLinkNotFound,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias1", OwnerID: uuid.New()}
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Validation error: missing range",
+			input:      &pb.GetLinkTimeseriesRequest{Alias: "alias1", Interval: "day"},
+			wantStatus: codes.InvalidArgument,
+			wantReason: grpc.ReasonValidation,
+		},
+		{
+			name:       "Validation error: unknown time zone",
+			input:      &pb.GetLinkTimeseriesRequest{Alias: "alias1", From: timestamppb.New(from), To: timestamppb.New(to), Interval: "day", Tz: "Mars/Olympus"},
+			wantStatus: codes.InvalidArgument,
+			wantReason: grpc.ReasonValidation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksStats.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			handler := grpc.NewHandlerLinkStats(ucStats.New(database, nil, nil))
+
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			// act
+			resp, err := handler.GetLinkTimeseries(ctx, tc.input)
+
+			// assert
+			st, _ := status.FromError(err)
+			assert.Equal(t, tc.wantStatus, st.Code())
+			if tc.wantOutput != nil {
+				require.NoError(t, err)
+				assert.True(t, proto.Equal(tc.wantOutput, resp))
+			}
+			if tc.wantStatus != codes.OK {
+				assert.Nil(t, resp)
+				assert.Equal(t, tc.wantReason, errorReason(st))
+			}
+		})
+	}
+}
+
+func TestListLinks(t *testing.T) {
+	owner := uuid.New()
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiredAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		input      *pb.ListLinksRequest
+		wantStatus codes.Code
+		wantOutput *pb.ListLinksResponse
+		wantReason string
+		setupMock  func(database *mocksList.Mockdatabase)
+	}{
+		{
+			name:       "Happy path",
+			ctx:        context.Background(),
+			input:      &pb.ListLinksRequest{Sort: "expired_at", Order: "asc", State: "active", Limit: 1},
+			wantStatus: codes.OK,
+			wantOutput: &pb.ListLinksResponse{
+				Links: []*pb.ListLinksItem{{
+					Url: "https://example.com", Alias: "alias1", ShortUrl: "https://sho.rt/alias1", RedirectCode: 302,
+					ExpiredAt: timestamppb.New(expiredAt), CreatedAt: timestamppb.New(createdAt),
+				}},
+				NextCursor: "eyJzIjoiZXhwaXJlZF9hdCIsImQiOmZhbHNlLCJ0IjoiMjAyNi0wMi0wMVQwMDowMDowMFoiLCJpZCI6IjAwMDAwMDAwLTAwMDAtMDAwMC0wMDAwLTAwMDAwMDAwMDAwMSJ9",
+			},
+			setupMock: func(database *mocksList.Mockdatabase) {
+				filter := entity.LinkFilter{State: entity.LinkStateActive, SortBy: entity.LinkSortExpiredAt, Limit: 2}
+				links := []entity.Link{
+					{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), URL: "https://example.com", Alias: "alias1", CreatedAt: createdAt, ExpiredAt: expiredAt},
+					{ID: uuid.New(), URL: "https://example.org", Alias: "alias2", CreatedAt: createdAt},
+				}
+				database.EXPECT().ListLinks(gomock.Any(), filter).Return(links, nil).Times(1)
+			},
+		},
+		{
+			name:       "Owner lists own protected links",
+			ctx:        entity.WithPrincipal(context.Background(), entity.Principal{OwnerID: owner}),
+			input:      &pb.ListLinksRequest{OwnerId: uuid.NewString()},
+			wantStatus: codes.OK,
+			wantOutput: &pb.ListLinksResponse{
+				Links: []*pb.ListLinksItem{{
+					Url: "https://example.com", Alias: "alias1", ShortUrl: "https://sho.rt/alias1", RedirectCode: 302,
+					PasswordProtected: true, CreatedAt: timestamppb.New(createdAt),
+				}},
+			},
+			setupMock: func(database *mocksList.Mockdatabase) {
+				filter := gomock.Cond(func(f entity.LinkFilter) bool { return f.OwnerID == owner })
+				links := []entity.Link{{URL: "https://example.com", Alias: "alias1", PasswordHash: "hash", OwnerID: owner, CreatedAt: createdAt}}
+				database.EXPECT().ListLinks(gomock.Any(), filter).Return(links, nil).Times(1)
+			},
+		},
+		{
+			name:       "Validation error: invalid owner",
+			ctx:        context.Background(),
+			input:      &pb.ListLinksRequest{OwnerId: "me"},
+			wantStatus: codes.InvalidArgument,
+			wantReason: grpc.ReasonValidation,
+		},
+		{
+			name:       "Validation error: invalid cursor",
+			ctx:        context.Background(),
+			input:      &pb.ListLinksRequest{Cursor: "garbage"},
+			wantStatus: codes.InvalidArgument,
+			wantReason: grpc.ReasonValidation,
+		},
+		{
+			name:       "Internal error",
+			ctx:        context.Background(),
+			input:      &pb.ListLinksRequest{},
+			wantStatus: codes.Internal,
+			wantReason: grpc.ReasonInternal,
+			setupMock: func(database *mocksList.Mockdatabase) {
+				database.EXPECT().ListLinks(gomock.Any(), gomock.Any()).Return(nil, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksList.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			handler := grpc.NewHandlerListLinks(ucList.New(database, shortURL))
+
+			// act
+			resp, err := handler.ListLinks(tc.ctx, tc.input)
+
+			// assert
+			st, _ := status.FromError(err)
+			assert.Equal(t, tc.wantStatus, st.Code())
+			if tc.wantOutput != nil {
+				require.NoError(t, err)
+				assert.True(t, proto.Equal(tc.wantOutput, resp))
+			}
+			if tc.wantStatus != codes.OK {
+				assert.Nil(t, resp)
+				assert.Equal(t, tc.wantReason, errorReason(st))
+			}
+		})
+	}
+}
+
+func errorReason(st *status.Status) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func TestCreateLinkFieldViolations(t *testing.T) {
+	uc := create.New(create.Config{}, nil, nil, adapterAlias.NewUUID(), shortURL, blocklist)
+	handler := grpc.NewHandlerCreateLink(uc)
+
+	_, err := handler.CreateLink(context.Background(), &pb.CreateLinkRequest{Url: "https://example.com", Alias: "a"})
+
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			violations = br.GetFieldViolations()
+		}
+	}
+	require.Len(t, violations, 1)
+	assert.Equal(t, "alias", violations[0].GetField())
+}
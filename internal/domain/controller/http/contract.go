@@ -0,0 +1,11 @@
+package http
+
+import (
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type clickTracker interface {
+	Track(input dto.RecordClickInput)
+}
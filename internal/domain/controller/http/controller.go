@@ -1,25 +1,114 @@
 package http
 
 import (
+	"context"
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
 
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
+	middlewareAuth "github.com/xgmsx/go-url-shortener-ddd/pkg/http/middlewares/auth"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
 )
 
 type Controller struct {
-	prefix   string
-	ucCreate create.Usecase
-	ucFetch  fetch.Usecase
+	prefix    string
+	ucCreate  create.Usecase
+	ucFetch   fetch.Usecase
+	ucUpdate  update.Usecase
+	ucDelete  remove.Usecase
+	ucList    list.Usecase
+	ucStats   stats.Usecase
+	ucAuth    auth.Usecase
+	tracker   clickTracker
+	rateLimit fiber.Handler
 }
 
-func New(prefix string, ucCreate create.Usecase, ucFetch fetch.Usecase) *Controller {
-	return &Controller{prefix, ucCreate, ucFetch}
+// New returns the controller of the HTTP API, rateLimit runs after the authentication, nil - no limits.
+func New(
+	prefix string,
+	ucCreate create.Usecase,
+	ucFetch fetch.Usecase,
+	ucUpdate update.Usecase,
+	ucDelete remove.Usecase,
+	ucList list.Usecase,
+	ucStats stats.Usecase,
+	ucAuth auth.Usecase,
+	tracker clickTracker,
+	rateLimit fiber.Handler,
+) *Controller {
+	return &Controller{prefix, ucCreate, ucFetch, ucUpdate, ucDelete, ucList, ucStats, ucAuth, tracker, rateLimit}
 }
 
 func (c *Controller) Register(app *fiber.App) {
+	authenticated := c.authMiddleware()
+	limited := c.rateLimitMiddleware()
+
 	r := app.Group(c.prefix)
-	r.Post("/link", NewHandlerCreateLink(c.ucCreate).Handler)
-	r.Get("/link/:alias", NewHandlerFetchLink(c.ucFetch).Handler)
-	r.Get("/link/:alias/redirect", NewHandlerRedirect(c.ucFetch).Handler)
+	r.Post("/link", authenticated, limited, NewHandlerCreateLink(c.ucCreate).Handler)
+	r.Post(`/links\:batch`, authenticated, limited, NewHandlerCreateLinks(c.ucCreate).Handler)
+	r.Get("/link/:alias", authenticated, limited, NewHandlerFetchLink(c.ucFetch).Handler)
+	r.Patch("/link/:alias", authenticated, limited, NewHandlerUpdateLink(c.ucUpdate).Handler)
+	r.Delete("/link/:alias", authenticated, limited, NewHandlerDeleteLink(c.ucDelete).Handler)
+	r.Get("/link/:alias/available", authenticated, limited, NewHandlerCheckAlias(c.ucCreate).Handler)
+	r.Get("/links", authenticated, limited, NewHandlerListLinks(c.ucList).Handler)
+	redirect := NewHandlerRedirect(c.ucFetch, c.tracker)
+	r.Get("/link/:alias/redirect", limited, redirect.Handler)
+	r.Post("/link/:alias/redirect", limited, redirect.Unlock)
+	r.Get("/link/:alias/stats", authenticated, limited, NewHandlerLinkStats(c.ucStats).Handler)
+	r.Get("/link/:alias/stats/timeseries", authenticated, limited, NewHandlerLinkTimeseries(c.ucStats).Handler)
+
+	// short links are shared as <public base url>/<alias>
+	app.Get("/:alias", limited, redirect.Handler)
+	app.Post("/:alias", limited, redirect.Unlock)
+}
+
+// authMiddleware requires an API key for the API routes, redirects stay anonymous.
+func (c *Controller) authMiddleware() fiber.Handler {
+	if !c.ucAuth.Enabled() {
+		return func(ctx *fiber.Ctx) error { return ctx.Next() }
+	}
+
+	return middlewareAuth.New(middlewareAuth.Config{Authenticate: c.authenticate})
+}
+
+// rateLimitMiddleware limits the requests after the authentication, so only verified API keys get own limits.
+func (c *Controller) rateLimitMiddleware() fiber.Handler {
+	if c.rateLimit == nil {
+		return func(ctx *fiber.Ctx) error { return ctx.Next() }
+	}
+
+	return c.rateLimit
+}
+
+// RateLimitKey counts the requests of the authenticated API key, anonymous requests per client IP.
+func RateLimitKey(c *fiber.Ctx) string {
+	var principal string
+	if p, ok := entity.PrincipalFromContext(c.UserContext()); ok {
+		principal = p.KeyID.String()
+	}
+
+	return ratelimit.ClientKey(principal, c.IP())
+}
+
+func (c *Controller) authenticate(ctx context.Context, key string) (context.Context, error) {
+	ctx, err := c.ucAuth.Authenticate(ctx, key)
+	if err != nil {
+		if errors.Is(err, entity.ErrUnauthorized) {
+			log.Warn().Err(err).Msg("uc.Authenticate: invalid api key")
+			return ctx, fiber.NewError(fiber.StatusUnauthorized, "invalid api key")
+		}
+		log.Error().Err(err).Msg("uc.Authenticate: internal error")
+		return ctx, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return ctx, nil
 }
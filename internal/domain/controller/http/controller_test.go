@@ -1,21 +1,42 @@
 package http
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
-	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
-	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	adapterAlias "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/alias"
+	mocksHTTP "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/http/mocks"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	ucAuth "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth"
+	mocksAuth "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/auth/mocks"
+	ucCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
+	mocksCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create/mocks"
+	ucFetch "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	mocksFetch "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch/mocks"
+	ucList "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	mocksList "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list/mocks"
+	ucDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	mocksDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove/mocks"
+	ucStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	ucUpdate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
+	mocksUpdate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update/mocks"
 )
 
 func TestController(t *testing.T) {
 	app := fiber.New()
 	app.Get("/test", func(c *fiber.Ctx) error { return c.SendString("Test response") })
 
-	ctrl := New("/test/api", create.Usecase{}, fetch.Usecase{})
+	ctrl := New("/test/api", ucCreate.Usecase{}, ucFetch.Usecase{}, ucUpdate.Usecase{}, ucDelete.Usecase{}, ucList.Usecase{}, ucStats.Usecase{}, ucAuth.Usecase{}, nil, nil)
 	ctrl.Register(app)
 
 	resp, body := sendHTTPRequest(t, app, http.MethodGet, "/test", "")
@@ -26,7 +47,241 @@ func TestController(t *testing.T) {
 	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
 	assert.Equal(t, "Method Not Allowed", body)
 
-	resp, body = sendHTTPRequest(t, app, http.MethodGet, "/unknown", "")
+	resp, body = sendHTTPRequest(t, app, http.MethodGet, "/a", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "validation error", body)
+
+	resp, body = sendHTTPRequest(t, app, http.MethodGet, "/unknown/path", "")
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
-	assert.Equal(t, "Cannot GET /unknown", body)
+	assert.Equal(t, "Cannot GET /unknown/path", body)
+}
+
+func TestControllerAuth(t *testing.T) {
+	owner, stranger := uuid.New(), uuid.New()
+	key := entity.APIKey{ID: uuid.New(), OwnerID: owner}
+
+	testCases := []struct {
+		name       string
+		method     string
+		url        string
+		body       string
+		apiKey     string
+		wantStatus int
+		wantOutput string
+		wantKey    string // the client counted by the rate limit, empty if the request is not limited
+		setupMock  func(m controllerMocks)
+	}{
+		{
+			name:       "Missing api key",
+			method:     http.MethodPost,
+			url:        "/api/link",
+			body:       `{"url": "https://example.com"}`,
+			wantStatus: http.StatusUnauthorized,
+			wantOutput: "missing api key",
+		},
+		{
+			name:       "Invalid api key",
+			method:     http.MethodPost,
+			url:        "/api/link",
+			body:       `{"url": "https://example.com"}`,
+			apiKey:     "sk_invalid",
+			wantStatus: http.StatusUnauthorized,
+			wantOutput: "invalid api key",
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), entity.HashAPIKey("sk_invalid")).Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Authentication error",
+			method:     http.MethodPost,
+			url:        "/api/link",
+			body:       `{"url": "https://example.com"}`,
+			apiKey:     "sk_valid",
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: "internal error",
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), gomock.Any()).Return(nil, errors.New("test db error")).Times(1)
+			},
+		},
+		{
+			name:       "Link is created for the owner",
+			method:     http.MethodPost,
+			url:        "/api/link",
+			body:       `{"url": "https://example.com"}`,
+			apiKey:     "sk_valid",
+			wantStatus: http.StatusCreated,
+			wantKey:    "key:" + key.ID.String(),
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), entity.HashAPIKey("sk_valid")).Return(&key, nil).Times(1)
+				link := gomock.Cond(func(l entity.Link) bool { return l.OwnerID == owner })
+				m.create.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				m.createCache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Batch of links is created for the owner",
+			method:     http.MethodPost,
+			url:        "/api/links:batch",
+			body:       `{"links": [{"url": "https://example.com"}, {"url": "https://example.org"}]}`,
+			apiKey:     "sk_valid",
+			wantStatus: http.StatusOK,
+			wantKey:    "key:" + key.ID.String(),
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), entity.HashAPIKey("sk_valid")).Return(&key, nil).Times(1)
+				links := gomock.Cond(func(links []entity.Link) bool {
+					return len(links) == 2 && links[0].OwnerID == owner && links[1].OwnerID == owner
+				})
+				m.create.EXPECT().CreateLinks(gomock.Any(), links).Return([]bool{true, true}, nil).Times(1)
+				m.createCache.EXPECT().PutLinks(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Owner sees the URL of a protected link",
+			method:     http.MethodGet,
+			url:        "/api/link/alias1",
+			apiKey:     "sk_valid",
+			wantStatus: http.StatusOK,
+			wantKey:    "key:" + key.ID.String(),
+			wantOutput: `{"url":"https://example.com","alias":"alias1","short_url":"https://sho.rt/alias1","expired_at":null,"redirect_code":302,"forward_query":false,"password_protected":true,"max_clicks":0}`,
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), gomock.Any()).Return(&key, nil).Times(1)
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", PasswordHash: "hash", OwnerID: owner}
+				m.fetchCache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link of another owner can't be updated",
+			method:     http.MethodPatch,
+			url:        "/api/link/alias1",
+			body:       `{"url": "https://example.org"}`,
+			apiKey:     "sk_valid",
+			wantStatus: http.StatusNotFound,
+			wantKey:    "key:" + key.ID.String(),
+			wantOutput: "not found",
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), gomock.Any()).Return(&key, nil).Times(1)
+				m.update.EXPECT().UpdateLink(gomock.Any(), "alias1", gomock.Any(), owner).Return(entity.Link{}, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Link is deleted by the owner",
+			method:     http.MethodDelete,
+			url:        "/api/link/alias1",
+			apiKey:     "sk_valid",
+			wantStatus: http.StatusNoContent,
+			wantKey:    "key:" + key.ID.String(),
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), gomock.Any()).Return(&key, nil).Times(1)
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", OwnerID: owner}
+				m.delete.EXPECT().DeleteLink(gomock.Any(), "alias1", owner).Return(link, nil).Times(1)
+				m.deleteCache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Links are listed for the owner only",
+			method:     http.MethodGet,
+			url:        "/api/links?owner_id=" + stranger.String(),
+			apiKey:     "sk_valid",
+			wantStatus: http.StatusOK,
+			wantKey:    "key:" + key.ID.String(),
+			wantOutput: `{"links":[]}`,
+			setupMock: func(m controllerMocks) {
+				m.auth.EXPECT().FindAPIKey(gomock.Any(), gomock.Any()).Return(&key, nil).Times(1)
+				filter := gomock.Cond(func(f entity.LinkFilter) bool { return f.OwnerID == owner })
+				m.list.EXPECT().ListLinks(gomock.Any(), filter).Return(nil, nil).Times(1)
+			},
+		},
+		{
+			name:       "Redirect is anonymous",
+			method:     http.MethodGet,
+			url:        "/alias1",
+			wantStatus: http.StatusFound,
+			wantKey:    "ip:0.0.0.0",
+			setupMock: func(m controllerMocks) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", OwnerID: owner}
+				m.fetchCache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				m.tracker.EXPECT().Track(gomock.Any()).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newControllerMocks(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			// arrange
+			var limitedKey string
+			rateLimit := func(c *fiber.Ctx) error {
+				limitedKey = RateLimitKey(c)
+				return c.Next()
+			}
+
+			app := fiber.New()
+			New(
+				"/api",
+				ucCreate.New(ucCreate.Config{}, m.create, m.createCache, adapterAlias.NewUUID(), shortURL, blocklist),
+				ucFetch.New(ucFetch.Config{}, nil, m.fetchCache, shortURL),
+				ucUpdate.New(entity.Lifetime{}, m.update, nil, shortURL, blocklist),
+				ucDelete.New(m.delete, m.deleteCache),
+				ucList.New(m.list, shortURL),
+				ucStats.Usecase{},
+				ucAuth.New(ucAuth.Config{Enabled: true}, m.auth),
+				m.tracker,
+				rateLimit,
+			).Register(app)
+
+			req := httptest.NewRequest(tc.method, tc.url, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.apiKey != "" {
+				req.Header.Set("X-API-Key", tc.apiKey)
+			}
+
+			// act
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			output, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			// assert
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantOutput != "" {
+				assert.Equal(t, tc.wantOutput, string(output))
+			}
+			assert.Equal(t, tc.wantKey, limitedKey)
+		})
+	}
+}
+
+type controllerMocks struct {
+	create      *mocksCreate.Mockdatabase
+	createCache *mocksCreate.Mockcache
+	fetchCache  *mocksFetch.Mockcache
+	update      *mocksUpdate.Mockdatabase
+	delete      *mocksDelete.Mockdatabase
+	deleteCache *mocksDelete.Mockcache
+	list        *mocksList.Mockdatabase
+	auth        *mocksAuth.Mockdatabase
+	tracker     *mocksHTTP.MockclickTracker
+}
+
+func newControllerMocks(ctrl *gomock.Controller) controllerMocks {
+	return controllerMocks{
+		create:      mocksCreate.NewMockdatabase(ctrl),
+		createCache: mocksCreate.NewMockcache(ctrl),
+		fetchCache:  mocksFetch.NewMockcache(ctrl),
+		update:      mocksUpdate.NewMockdatabase(ctrl),
+		delete:      mocksDelete.NewMockdatabase(ctrl),
+		deleteCache: mocksDelete.NewMockcache(ctrl),
+		list:        mocksList.NewMockdatabase(ctrl),
+		auth:        mocksAuth.NewMockdatabase(ctrl),
+		tracker:     mocksHTTP.NewMockclickTracker(ctrl),
+	}
 }
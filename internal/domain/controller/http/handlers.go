@@ -2,14 +2,24 @@ package http
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
 	_ "github.com/xgmsx/go-url-shortener-ddd/pkg/http"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
 )
@@ -32,11 +42,15 @@ func NewHandlerCreateLink(uc create.Usecase) *HandlerCreateLink {
 // @Success 201 {object} dto.CreateLinkOutput
 // @Success 302 {object} dto.CreateLinkOutput
 // @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
 // @Failure 404 {object} http.ErrHTTP
+// @Failure 409 {object} http.ErrHTTP
+// @Failure 422 {object} http.ErrHTTP "the url matches the blocklist"
 // @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
 // @Router /shortener/v1/link [post]
 func (h *HandlerCreateLink) Handler(c *fiber.Ctx) error {
-	ctx, span := tracer.Start(c.Context(), "http/v1 CreateLink")
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 CreateLink")
 	defer span.End()
 
 	var input dto.CreateLinkInput
@@ -53,7 +67,16 @@ func (h *HandlerCreateLink) Handler(c *fiber.Ctx) error {
 	output, err := h.uc.Create(ctx, input)
 	if err != nil {
 		switch {
-		case errors.Is(err, entity.ErrAlreadyExist):
+		case errors.Is(err, entity.ErrInputValidation):
+			log.Error().Err(err).Msg("uc.CreateLink: validation error")
+			return fiber.NewError(fiber.StatusBadRequest, "validation error")
+		case errors.Is(err, entity.ErrBlocked):
+			log.Warn().Err(err).Msg("uc.CreateLink: url is blocked")
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "url is blocked")
+		case errors.Is(err, entity.ErrAliasTaken) && input.Alias != "":
+			log.Error().Err(err).Msg("uc.CreateLink: alias already exists")
+			return fiber.NewError(fiber.StatusConflict, "alias already exists")
+		case errors.Is(err, entity.ErrAlreadyExist) && !errors.Is(err, entity.ErrAliasTaken):
 			return c.Status(fiber.StatusFound).JSON(output)
 		default:
 			log.Error().Err(err).Msg("uc.CreateLink: internal error")
@@ -64,6 +87,115 @@ func (h *HandlerCreateLink) Handler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(output)
 }
 
+type HandlerCreateLinks struct {
+	uc create.Usecase
+}
+
+func NewHandlerCreateLinks(uc create.Usecase) *HandlerCreateLinks {
+	return &HandlerCreateLinks{uc: uc}
+}
+
+// Handler CreateLinks
+//
+// @Summary Create up to 1000 short links at once
+// @Description The result of each link is reported in the order of the input, invalid links and taken aliases
+// @Description don't fail the whole batch.
+// @Tags Links
+// @Accept json
+// @Produce json
+// @Param input body dto.CreateLinksInput true "New links"
+// @Success 200 {object} dto.CreateLinksOutput
+// @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
+// @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
+// @Router /shortener/v1/links:batch [post]
+func (h *HandlerCreateLinks) Handler(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 CreateLinks")
+	defer span.End()
+
+	var input dto.CreateLinksInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Error().Err(err).Msg("c.BodyParser")
+		return fiber.NewError(fiber.StatusBadRequest, "invalid json")
+	}
+
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.CreateLinks: validation error")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	output, err := h.uc.CreateBatch(ctx, input)
+	if err != nil {
+		log.Error().Err(err).Msg("uc.CreateLinks: internal error")
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	for i := range output.Links {
+		if output.Links[i].Err != nil {
+			output.Links[i].Error = batchError(output.Links[i].Err)
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(output)
+}
+
+// batchError returns the message of the error of a link in the batch.
+func batchError(err error) string {
+	var verr *entity.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		return "validation error: " + verr.Field + " " + verr.Description
+	case errors.Is(err, entity.ErrBlocked):
+		return "url is blocked"
+	case errors.Is(err, entity.ErrAliasTaken):
+		return "alias already exists"
+	default:
+		log.Error().Err(err).Msg("uc.CreateLinks: internal error")
+		return "internal error"
+	}
+}
+
+type HandlerCheckAlias struct {
+	uc create.Usecase
+}
+
+func NewHandlerCheckAlias(uc create.Usecase) *HandlerCheckAlias {
+	return &HandlerCheckAlias{uc: uc}
+}
+
+// Handler CheckAlias
+//
+// @Summary Check whether an alias is available for a new link
+// @Tags Links
+// @Accept plain
+// @Produce json
+// @Param alias path string true "Link alias"
+// @Success 200 {object} dto.CheckAliasOutput
+// @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
+// @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
+// @Router /shortener/v1/link/{alias}/available [get]
+func (h *HandlerCheckAlias) Handler(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 CheckAlias")
+	defer span.End()
+
+	input := dto.CheckAliasInput{Alias: c.Params("alias")}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.CheckAlias: validation error")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	output, err := h.uc.CheckAlias(ctx, input)
+	if err != nil {
+		log.Error().Err(err).Msg("uc.CheckAlias: internal error")
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(output)
+}
+
 type HandlerFetchLink struct {
 	uc fetch.Usecase
 }
@@ -81,11 +213,14 @@ func NewHandlerFetchLink(uc fetch.Usecase) *HandlerFetchLink {
 // @Param alias path string true "Link alias"
 // @Success 200 {object} dto.FetchLinkOutput
 // @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
 // @Failure 404 {object} http.ErrHTTP
+// @Failure 410 {object} http.ErrHTTP
 // @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
 // @Router /shortener/v1/link/{alias} [get]
 func (h *HandlerFetchLink) Handler(c *fiber.Ctx) error {
-	ctx, span := tracer.Start(c.Context(), "http/v1 FetchLink")
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 FetchLink")
 	defer span.End()
 
 	alias := c.Params("alias")
@@ -102,6 +237,9 @@ func (h *HandlerFetchLink) Handler(c *fiber.Ctx) error {
 		case errors.Is(err, entity.ErrNotFound):
 			log.Error().Err(err).Msg("uc.FetchLink: not found")
 			return fiber.NewError(fiber.StatusNotFound, "not found")
+		case errors.Is(err, entity.ErrExpired):
+			log.Error().Err(err).Msg("uc.FetchLink: expired")
+			return fiber.NewError(fiber.StatusGone, "link expired")
 		default:
 			log.Error().Err(err).Msg("uc.FetchLink: internal error")
 			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
@@ -111,12 +249,106 @@ func (h *HandlerFetchLink) Handler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(output)
 }
 
+type HandlerListLinks struct {
+	uc list.Usecase
+}
+
+func NewHandlerListLinks(uc list.Usecase) *HandlerListLinks {
+	return &HandlerListLinks{uc: uc}
+}
+
+// Handler ListLinks
+//
+// @Summary List short links page by page
+// @Tags Links
+// @Accept plain
+// @Produce json
+// @Param owner_id query string false "Owner of the links, ignored for API keys which list their own links"
+// @Param created_from query string false "Created at or after (RFC 3339)"
+// @Param created_to query string false "Created before (RFC 3339)"
+// @Param state query string false "Expiry state" Enums(active, expired)
+// @Param domain query string false "Substring of the target domain"
+// @Param sort query string false "Sort key, default created_at" Enums(created_at, expired_at)
+// @Param order query string false "Sort order, default desc" Enums(asc, desc)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Size of the page (1-100, default 50)"
+// @Success 200 {object} dto.ListLinksOutput
+// @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
+// @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
+// @Router /shortener/v1/links [get]
+func (h *HandlerListLinks) Handler(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 ListLinks")
+	defer span.End()
+
+	input, err := listLinksInput(c)
+	if err != nil {
+		log.Error().Err(err).Msg("uc.ListLinks: invalid query")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	if err = input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.ListLinks: validation error")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	output, err := h.uc.List(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInputValidation):
+			log.Error().Err(err).Msg("uc.ListLinks: validation error")
+			return fiber.NewError(fiber.StatusBadRequest, "validation error")
+		default:
+			log.Error().Err(err).Msg("uc.ListLinks: internal error")
+			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(output)
+}
+
+func listLinksInput(c *fiber.Ctx) (dto.ListLinksInput, error) {
+	input := dto.ListLinksInput{
+		State:  c.Query("state"),
+		Domain: c.Query("domain"),
+		Sort:   c.Query("sort"),
+		Order:  c.Query("order"),
+		Cursor: c.Query("cursor"),
+	}
+
+	var err error
+	if v := c.Query("owner_id"); v != "" {
+		if input.OwnerID, err = uuid.Parse(v); err != nil {
+			return input, fmt.Errorf("uuid.Parse: owner_id: %w", err)
+		}
+	}
+	if v := c.Query("created_from"); v != "" {
+		if input.CreatedFrom, err = time.Parse(time.RFC3339, v); err != nil {
+			return input, fmt.Errorf("time.Parse: created_from: %w", err)
+		}
+	}
+	if v := c.Query("created_to"); v != "" {
+		if input.CreatedTo, err = time.Parse(time.RFC3339, v); err != nil {
+			return input, fmt.Errorf("time.Parse: created_to: %w", err)
+		}
+	}
+	if v := c.Query("limit"); v != "" {
+		if input.Limit, err = strconv.Atoi(v); err != nil {
+			return input, fmt.Errorf("strconv.Atoi: limit: %w", err)
+		}
+	}
+
+	return input, nil
+}
+
 type HandlerRedirect struct {
-	uc fetch.Usecase
+	uc      fetch.Usecase
+	tracker clickTracker
 }
 
-func NewHandlerRedirect(uc fetch.Usecase) *HandlerRedirect {
-	return &HandlerRedirect{uc: uc}
+func NewHandlerRedirect(uc fetch.Usecase, t clickTracker) *HandlerRedirect {
+	return &HandlerRedirect{uc: uc, tracker: t}
 }
 
 // Handler Redirect
@@ -126,34 +358,422 @@ func NewHandlerRedirect(uc fetch.Usecase) *HandlerRedirect {
 // @Accept       plain
 // @Produce      plain
 // @Param        alias path string true "Link alias"
+// @Success      301 "permanent redirect to the original url"
 // @Success      302 "redirect to the original url"
+// @Success      307 "temporary redirect to the original url"
+// @Success      308 "permanent redirect to the original url"
+// @Success      200 "the password prompt of a protected link"
 // @Failure      400 {object} http.ErrHTTP
 // @Failure      404 {object} http.ErrHTTP
+// @Failure      410 {object} http.ErrHTTP "the link is expired, blocked or has no clicks left"
 // @Failure      500 {object} http.ErrHTTP
 // @Router       /shortener/v1/link/{alias}/redirect [get]
 func (h *HandlerRedirect) Handler(c *fiber.Ctx) error {
-	ctx, span := tracer.Start(c.Context(), "http/v1 Redirect")
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 Redirect")
 	defer span.End()
 
 	alias := c.Params("alias")
 
 	input := dto.FetchLinkInput{Alias: alias}
 	if err := input.Validate(); err != nil {
-		log.Error().Msg("uc.FetchLink: alias is required")
+		log.Error().Msg("uc.Redirect: alias is required")
 		return fiber.NewError(fiber.StatusBadRequest, "validation error")
 	}
 
-	output, err := h.uc.Fetch(ctx, input)
+	output, err := h.uc.Redirect(ctx, input)
 	if err != nil {
 		switch {
+		case errors.Is(err, entity.ErrExhausted):
+			log.Error().Err(err).Msg("uc.Redirect: exhausted")
+			return fiber.NewError(fiber.StatusGone, "link exhausted")
+		case errors.Is(err, entity.ErrBlocked):
+			log.Warn().Err(err).Msg("uc.Redirect: blocked")
+			return fiber.NewError(fiber.StatusGone, "link blocked")
 		case errors.Is(err, entity.ErrNotFound):
-			log.Error().Err(err).Msg("uc.FetchLink: not found")
+			log.Error().Err(err).Msg("uc.Redirect: not found")
 			return fiber.NewError(fiber.StatusNotFound, "not found")
+		case errors.Is(err, entity.ErrExpired):
+			log.Error().Err(err).Msg("uc.Redirect: expired")
+			return fiber.NewError(fiber.StatusGone, "link expired")
 		default:
-			log.Error().Err(err).Msg("uc.FetchLink: internal error")
+			log.Error().Err(err).Msg("uc.Redirect: internal error")
+			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+		}
+	}
+
+	if output.PasswordProtected {
+		return renderPasswordPrompt(c, fiber.StatusOK, passwordPromptData{Alias: output.Alias})
+	}
+
+	c.Set(fiber.HeaderCacheControl, redirectCacheControl(output, time.Now()))
+
+	return redirect(c, h.tracker, output, output.RedirectCode)
+}
+
+// Unlock
+//
+// @Summary      Open a password-protected link
+// @Tags         Links
+// @Accept       x-www-form-urlencoded
+// @Produce      html
+// @Param        alias path string true "Link alias"
+// @Param        password formData string true "Link password"
+// @Success      303 "redirect to the original url"
+// @Failure      400 {object} http.ErrHTTP
+// @Failure      401 "the password prompt, the password is wrong"
+// @Failure      404 {object} http.ErrHTTP
+// @Failure      410 {object} http.ErrHTTP "the link is expired, blocked or has no clicks left"
+// @Failure      429 "the password prompt, too many wrong passwords"
+// @Failure      500 {object} http.ErrHTTP
+// @Router       /shortener/v1/link/{alias}/redirect [post]
+func (h *HandlerRedirect) Unlock(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 Unlock")
+	defer span.End()
+
+	input := dto.UnlockLinkInput{
+		Alias:    c.Params("alias"),
+		Password: c.FormValue("password"),
+		Client:   c.IP(),
+	}
+	if err := input.Validate(); err != nil {
+		log.Error().Msg("uc.UnlockLink: alias is required")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	output, err := h.uc.Unlock(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInvalidPassword):
+			log.Warn().Err(err).Msg("uc.UnlockLink: invalid password")
+			return renderPasswordPrompt(c, fiber.StatusUnauthorized,
+				passwordPromptData{Alias: input.Alias, Error: "Wrong password, try again."})
+		case errors.Is(err, entity.ErrTooManyAttempts):
+			log.Warn().Err(err).Msg("uc.UnlockLink: too many attempts")
+			return renderPasswordPrompt(c, fiber.StatusTooManyRequests,
+				passwordPromptData{Alias: input.Alias, Error: "Too many wrong passwords, try again later."})
+		case errors.Is(err, entity.ErrExhausted):
+			log.Error().Err(err).Msg("uc.UnlockLink: exhausted")
+			return fiber.NewError(fiber.StatusGone, "link exhausted")
+		case errors.Is(err, entity.ErrBlocked):
+			log.Warn().Err(err).Msg("uc.UnlockLink: blocked")
+			return fiber.NewError(fiber.StatusGone, "link blocked")
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.UnlockLink: not found")
+			return fiber.NewError(fiber.StatusNotFound, "not found")
+		case errors.Is(err, entity.ErrExpired):
+			log.Error().Err(err).Msg("uc.UnlockLink: expired")
+			return fiber.NewError(fiber.StatusGone, "link expired")
+		default:
+			log.Error().Err(err).Msg("uc.UnlockLink: internal error")
+			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+		}
+	}
+
+	// 303 makes the browser follow with GET instead of posting the password to the target,
+	// the unlocked redirect is never cached, so the next visit asks for the password again
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+
+	return redirect(c, h.tracker, output, fiber.StatusSeeOther)
+}
+
+// redirect records the click and redirects to the link URL.
+func redirect(c *fiber.Ctx, tracker clickTracker, output dto.FetchLinkOutput, status int) error {
+	// request values are only valid within the handler, so they are copied for the tracker
+	tracker.Track(dto.RecordClickInput{
+		Alias:     output.Alias,
+		ClickedAt: time.Now(),
+		IP:        utils.CopyString(c.IP()),
+		Referrer:  utils.CopyString(c.Get(fiber.HeaderReferer)),
+		UserAgent: utils.CopyString(c.Get(fiber.HeaderUserAgent)),
+	})
+
+	target := output.URL
+	if output.ForwardQuery {
+		target = mergeQuery(target, string(c.Request().URI().QueryString()))
+	}
+
+	return c.Redirect(target, status)
+}
+
+// redirectCacheControl lets clients privately cache permanent redirects for the max age of the output,
+// but not after the link expires. Temporary redirects and redirects of limited links are not cached,
+// so every click reaches the service.
+func redirectCacheControl(output dto.FetchLinkOutput, now time.Time) string {
+	if !entity.IsPermanentRedirect(output.RedirectCode) || output.MaxClicks > 0 {
+		return "private, no-store"
+	}
+
+	maxAge := output.CacheMaxAge
+	if output.ExpiredAt != nil {
+		maxAge = min(maxAge, output.ExpiredAt.Sub(now))
+	}
+	if maxAge < time.Second {
+		return "private, no-store"
+	}
+
+	return fmt.Sprintf("private, max-age=%d", int64(maxAge.Seconds()))
+}
+
+// mergeQuery adds the request query parameters to the target URL,
+// parameters already set in the target URL take precedence.
+func mergeQuery(target, query string) string {
+	if query == "" {
+		return target
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+
+	incoming, err := url.ParseQuery(query)
+	if err != nil {
+		log.Warn().Err(err).Msg("url.ParseQuery: some parameters are dropped")
+	}
+
+	existing := u.Query()
+	extra := url.Values{}
+	for k, v := range incoming {
+		if !existing.Has(k) {
+			extra[k] = v
+		}
+	}
+	if len(extra) == 0 {
+		return target
+	}
+
+	if u.RawQuery != "" {
+		u.RawQuery += "&"
+	}
+	u.RawQuery += extra.Encode()
+
+	return u.String()
+}
+
+type HandlerLinkStats struct {
+	uc stats.Usecase
+}
+
+func NewHandlerLinkStats(uc stats.Usecase) *HandlerLinkStats {
+	return &HandlerLinkStats{uc: uc}
+}
+
+// Handler LinkStats
+//
+// @Summary Get click statistics of a short link
+// @Tags Stats
+// @Accept plain
+// @Produce json
+// @Param alias path string true "Link alias"
+// @Param top query int false "Size of the breakdowns (1-100, default 10)"
+// @Success 200 {object} dto.LinkStatsOutput
+// @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
+// @Failure 404 {object} http.ErrHTTP
+// @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
+// @Router /shortener/v1/link/{alias}/stats [get]
+func (h *HandlerLinkStats) Handler(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 LinkStats")
+	defer span.End()
+
+	var input dto.LinkStatsInput
+	if err := c.QueryParser(&input); err != nil {
+		log.Error().Err(err).Msg("c.QueryParser")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+	input.Alias = c.Params("alias")
+
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.LinkStats: validation error")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	output, err := h.uc.Stats(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.LinkStats: not found")
+			return fiber.NewError(fiber.StatusNotFound, "not found")
+		default:
+			log.Error().Err(err).Msg("uc.LinkStats: internal error")
+			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(output)
+}
+
+type HandlerLinkTimeseries struct {
+	uc stats.Usecase
+}
+
+func NewHandlerLinkTimeseries(uc stats.Usecase) *HandlerLinkTimeseries {
+	return &HandlerLinkTimeseries{uc: uc}
+}
+
+// Handler LinkTimeseries
+//
+// @Summary Get a click histogram of a short link
+// @Tags Stats
+// @Accept plain
+// @Produce json
+// @Param alias path string true "Link alias"
+// @Param from query string true "Start of the range, inclusive (RFC 3339)"
+// @Param to query string true "End of the range, exclusive (RFC 3339)"
+// @Param interval query string true "Bucket size" Enums(hour, day, week)
+// @Param tz query string false "IANA time zone of the buckets, default UTC"
+// @Success 200 {object} dto.LinkTimeseriesOutput
+// @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
+// @Failure 404 {object} http.ErrHTTP
+// @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
+// @Router /shortener/v1/link/{alias}/stats/timeseries [get]
+func (h *HandlerLinkTimeseries) Handler(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 LinkTimeseries")
+	defer span.End()
+
+	input := dto.LinkTimeseriesInput{
+		Alias:    c.Params("alias"),
+		Interval: c.Query("interval"),
+		TimeZone: c.Query("tz"),
+	}
+
+	var err error
+	if input.From, err = time.Parse(time.RFC3339, c.Query("from")); err != nil {
+		log.Error().Err(err).Msg("time.Parse: from")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+	if input.To, err = time.Parse(time.RFC3339, c.Query("to")); err != nil {
+		log.Error().Err(err).Msg("time.Parse: to")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	if err = input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.LinkTimeseries: validation error")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	output, err := h.uc.Timeseries(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.LinkTimeseries: not found")
+			return fiber.NewError(fiber.StatusNotFound, "not found")
+		default:
+			log.Error().Err(err).Msg("uc.LinkTimeseries: internal error")
+			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(output)
+}
+
+type HandlerUpdateLink struct {
+	uc update.Usecase
+}
+
+func NewHandlerUpdateLink(uc update.Usecase) *HandlerUpdateLink {
+	return &HandlerUpdateLink{uc: uc}
+}
+
+// Handler UpdateLink
+//
+// @Summary Update the URL and/or expiration of a short link
+// @Tags Links
+// @Accept json
+// @Produce json
+// @Param alias path string true "Link alias"
+// @Param input body dto.UpdateLinkInput true "Changed fields"
+// @Success 200 {object} dto.UpdateLinkOutput
+// @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
+// @Failure 404 {object} http.ErrHTTP
+// @Failure 422 {object} http.ErrHTTP "the url matches the blocklist"
+// @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
+// @Router /shortener/v1/link/{alias} [patch]
+func (h *HandlerUpdateLink) Handler(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 UpdateLink")
+	defer span.End()
+
+	var input dto.UpdateLinkInput
+	if err := c.BodyParser(&input); err != nil {
+		log.Error().Err(err).Msg("c.BodyParser")
+		return fiber.NewError(fiber.StatusBadRequest, "invalid json")
+	}
+	input.Alias = c.Params("alias")
+
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.UpdateLink: validation error")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	output, err := h.uc.Update(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInputValidation):
+			log.Error().Err(err).Msg("uc.UpdateLink: validation error")
+			return fiber.NewError(fiber.StatusBadRequest, "validation error")
+		case errors.Is(err, entity.ErrBlocked):
+			log.Warn().Err(err).Msg("uc.UpdateLink: url is blocked")
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "url is blocked")
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.UpdateLink: not found")
+			return fiber.NewError(fiber.StatusNotFound, "not found")
+		default:
+			log.Error().Err(err).Msg("uc.UpdateLink: internal error")
+			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(output)
+}
+
+type HandlerDeleteLink struct {
+	uc remove.Usecase
+}
+
+func NewHandlerDeleteLink(uc remove.Usecase) *HandlerDeleteLink {
+	return &HandlerDeleteLink{uc: uc}
+}
+
+// Handler DeleteLink
+//
+// @Summary Delete a short link by alias
+// @Tags Links
+// @Accept plain
+// @Produce plain
+// @Param alias path string true "Link alias"
+// @Success 204 "link deleted"
+// @Failure 400 {object} http.ErrHTTP
+// @Failure 401 {object} http.ErrHTTP "missing or invalid api key"
+// @Failure 404 {object} http.ErrHTTP
+// @Failure 500 {object} http.ErrHTTP
+// @Security ApiKeyAuth
+// @Router /shortener/v1/link/{alias} [delete]
+func (h *HandlerDeleteLink) Handler(c *fiber.Ctx) error {
+	ctx, span := tracer.Start(c.UserContext(), "http/v1 DeleteLink")
+	defer span.End()
+
+	input := dto.DeleteLinkInput{Alias: c.Params("alias")}
+	if err := input.Validate(); err != nil {
+		log.Error().Err(err).Msg("uc.DeleteLink: validation error")
+		return fiber.NewError(fiber.StatusBadRequest, "validation error")
+	}
+
+	err := h.uc.Delete(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrNotFound):
+			log.Error().Err(err).Msg("uc.DeleteLink: not found")
+			return fiber.NewError(fiber.StatusNotFound, "not found")
+		default:
+			log.Error().Err(err).Msg("uc.DeleteLink: internal error")
 			return fiber.NewError(fiber.StatusInternalServerError, "internal error")
 		}
 	}
 
-	return c.Redirect(output.URL, fiber.StatusFound)
+	return c.SendStatus(fiber.StatusNoContent)
 }
@@ -2,51 +2,199 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	adapterAlias "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/alias"
+	adapterBlocklist "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/blocklist"
+	adapterShortURL "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/shorturl"
+	mocksHTTP "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/http/mocks"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	ucCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	mocksCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create/mocks"
 	ucFetch "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch"
 	mocksFetch "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/fetch/mocks"
+	ucList "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list"
+	mocksList "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/list/mocks"
+	ucDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	mocksDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove/mocks"
+	ucStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	mocksStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats/mocks"
+	ucUpdate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update"
+	mocksUpdate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/update/mocks"
 )
 
+var shortURL, _ = adapterShortURL.New(adapterShortURL.Config{BaseURL: "https://sho.rt"})
+
+var blocklist, _ = adapterBlocklist.New(adapterBlocklist.Config{File: "testdata/blocklist.txt"})
+
 func TestCreateLink(t *testing.T) {
-	initMock := func() (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache, *mocksCreate.Mockpublisher) {
+	initMock := func() (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache) {
 		ctrl := gomock.NewController(t)
-		publisher := mocksCreate.NewMockpublisher(ctrl)
 		database := mocksCreate.NewMockdatabase(ctrl)
 		cache := mocksCreate.NewMockcache(ctrl)
-		return ctrl, database, cache, publisher
+		return ctrl, database, cache
 	}
 
 	testCases := []struct {
 		name       string
+		config     ucCreate.Config
 		input      string
 		wantStatus int
 		wantOutput string
-		setupMock  func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher)
+		setupMock  func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache)
 	}{
 		{
 			name:       "Happy path",
 			input:      `{"url": "https://example.com"}`,
 			wantStatus: http.StatusCreated,
-			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with custom alias",
+			input:      `{"url": "https://example.com", "alias": "promo-2026"}`,
+			wantStatus: http.StatusCreated,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool { return l.Alias == "promo-2026" })
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with redirect options",
+			input:      `{"url": "https://example.com", "redirect_code": 301, "forward_query": true}`,
+			wantStatus: http.StatusCreated,
+			wantOutput: `"redirect_code":301,"forward_query":true`,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool { return l.RedirectCode == http.StatusMovedPermanently && l.ForwardQuery })
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Default redirect code from config",
+			config:     ucCreate.Config{RedirectCode: http.StatusPermanentRedirect},
+			input:      `{"url": "https://example.com"}`,
+			wantStatus: http.StatusCreated,
+			wantOutput: `"redirect_code":308,"forward_query":false`,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-				publisher.EXPECT().SendLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
+		{
+			name:       "Validation error: unsupported redirect code",
+			input:      `{"url": "https://example.com", "redirect_code": 303}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Custom alias already taken",
+			input:      `{"url": "https://example.com", "alias": "promo-2026"}`,
+			wantStatus: http.StatusConflict,
+			wantOutput: "alias already exists",
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAliasTaken).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with custom expiration",
+			input:      `{"url": "https://example.com", "expires_in": 3600}`,
+			wantStatus: http.StatusCreated,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool {
+					return time.Until(l.ExpiredAt) > 59*time.Minute && time.Until(l.ExpiredAt) <= time.Hour
+				})
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with never expiring link",
+			input:      `{"url": "https://example.com", "never_expires": true}`,
+			wantStatus: http.StatusCreated,
+			wantOutput: `"expired_at":null`,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool { return l.ExpiredAt.IsZero() })
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Never expiring link is not allowed",
+			config:     ucCreate.Config{MaxTTL: 48 * time.Hour},
+			input:      `{"url": "https://example.com", "never_expires": true}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Expiration exceeds max TTL",
+			config:     ucCreate.Config{MaxTTL: 48 * time.Hour},
+			input:      `{"url": "https://example.com", "expired_at": "2100-01-01T00:00:00Z"}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Expiration in the past",
+			input:      `{"url": "https://example.com", "expired_at": "2000-01-01T00:00:00Z"}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Conflicting expiration fields",
+			input:      `{"url": "https://example.com", "expires_in": 60, "never_expires": true}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Validation error: expires_in overflow",
+			input:      `{"url": "https://example.com", "expires_in": 9223372036854775}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Generated alias collision is retried",
+			input:      `{"url": "https://example.com"}`,
+			wantStatus: http.StatusCreated,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				first := database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAliasTaken).Times(1)
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).After(first).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Generated alias collision retries exhausted",
+			config:     ucCreate.Config{AliasAttempts: 3},
+			input:      `{"url": "https://example.com"}`,
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: "internal error",
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAliasTaken).Times(3)
+			},
+		},
+		{
+			name:       "Invalid custom alias",
+			input:      `{"url": "https://example.com", "alias": "promo 2026!"}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
 		{
 			name:       "Invalid JSON",
 			input:      `test text`,
@@ -63,16 +211,119 @@ func TestCreateLink(t *testing.T) {
 			name:       "Link already exists",
 			input:      `{"url": "https://example.com"}`,
 			wantStatus: http.StatusFound,
-			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAlreadyExist).Times(1)
 			},
 		},
+		{
+			name:       "Dedup: new URL",
+			config:     ucCreate.Config{Dedup: true},
+			input:      `{"url": "https://example.com"}`,
+			wantStatus: http.StatusCreated,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().FindOrCreateLink(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, l entity.Link) (entity.Link, error) { return l, nil }).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Dedup: already shortened URL",
+			config:     ucCreate.Config{Dedup: true},
+			input:      `{"url": "https://example.com"}`,
+			wantStatus: http.StatusFound,
+			wantOutput: `{"url":"https://example.com","alias":"existing","short_url":"https://sho.rt/existing","expired_at":null,"redirect_code":302,"forward_query":false,"password_protected":false,"max_clicks":0}`,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				existing := entity.Link{URL: "https://example.com", Alias: "existing"}
+				database.EXPECT().FindOrCreateLink(gomock.Any(), gomock.Any()).Return(existing, entity.ErrAlreadyExist).Times(1)
+			},
+		},
+		{
+			name:       "Dedup: custom alias always creates a new link",
+			config:     ucCreate.Config{Dedup: true},
+			input:      `{"url": "https://example.com", "alias": "promo-2026"}`,
+			wantStatus: http.StatusCreated,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Dedup: password-protected link always creates a new link",
+			config:     ucCreate.Config{Dedup: true},
+			input:      `{"url": "https://example.com", "password": "secret"}`,
+			wantStatus: http.StatusCreated,
+			wantOutput: `"password_protected":true`,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool { return l.IsProtected() && l.CheckPassword("secret") })
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with one-time link",
+			config:     ucCreate.Config{Dedup: true},
+			input:      `{"url": "https://example.com", "max_clicks": 1}`,
+			wantStatus: http.StatusCreated,
+			wantOutput: `"max_clicks":1`,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool { return l.MaxClicks == 1 })
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "URL is normalized",
+			input:      `{"url": "HTTP://Пример.РФ:80/path?q=1"}`,
+			wantStatus: http.StatusCreated,
+			wantOutput: `"url":"http://xn--e1afmkfd.xn--p1ai/path?q=1"`,
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool { return l.URL == "http://xn--e1afmkfd.xn--p1ai/path?q=1" })
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "URL domain is blocked",
+			input:      `{"url": "https://login.EVIL.com/account"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantOutput: "url is blocked",
+		},
+		{
+			name:       "URL pattern is blocked",
+			input:      `{"url": "https://docs.example.com/view?id=1&redirect=https://evil.org"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantOutput: "url is blocked",
+		},
+		{
+			name:       "Validation error: javascript URL",
+			input:      `{"url": "javascript:alert(1)"}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Validation error: loopback address",
+			input:      `{"url": "http://127.0.0.1:8000/api"}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Validation error: negative max clicks",
+			input:      `{"url": "https://example.com", "max_clicks": -1}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
+		{
+			name:       "Validation error: short password",
+			input:      `{"url": "https://example.com", "password": "abc"}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: "validation error",
+		},
 		{
 			name:       "Internal error",
 			input:      `{"url": "https://example.com"}`,
 			wantStatus: http.StatusInternalServerError,
 			wantOutput: "internal error",
-			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(errors.New("test error")).Times(1)
 			},
 		},
@@ -80,15 +331,15 @@ func TestCreateLink(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			ctrl, database, cache, publisher := initMock()
+			ctrl, database, cache := initMock()
 			defer ctrl.Finish()
 
 			if tc.setupMock != nil {
-				tc.setupMock(database, cache, publisher)
+				tc.setupMock(database, cache)
 			}
 
 			// arrange
-			uc := ucCreate.New(database, cache, publisher)
+			uc := ucCreate.New(tc.config, database, cache, adapterAlias.NewUUID(), shortURL, blocklist)
 
 			srv := fiber.New()
 			srv.Add(http.MethodPost, "/create", NewHandlerCreateLink(uc).Handler)
@@ -101,74 +352,154 @@ func TestCreateLink(t *testing.T) {
 				assert.Equal(t, tc.wantStatus, resp.StatusCode)
 			}
 			if tc.wantOutput != "" {
-				assert.Equal(t, tc.wantOutput, output)
+				assert.Contains(t, output, tc.wantOutput)
 			}
 		})
 	}
 }
 
-func TestFetchLink(t *testing.T) {
-	initMock := func() (*gomock.Controller, *mocksFetch.Mockdatabase, *mocksFetch.Mockcache) {
+func TestCreateLinkReservedGeneratedAlias(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	database := mocksCreate.NewMockdatabase(ctrl)
+	cache := mocksCreate.NewMockcache(ctrl)
+	generator := mocksCreate.NewMockaliasGenerator(ctrl)
+
+	reserved := generator.EXPECT().Generate(gomock.Any()).Return("ready", nil).Times(1)
+	generator.EXPECT().Generate(gomock.Any()).Return("r3ady", nil).After(reserved).Times(1)
+	database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	// arrange
+	uc := ucCreate.New(ucCreate.Config{}, database, cache, generator, shortURL, blocklist)
+
+	srv := fiber.New()
+	srv.Add(http.MethodPost, "/create", NewHandlerCreateLink(uc).Handler)
+
+	// act
+	resp, output := sendHTTPRequest(t, srv, http.MethodPost, "/create", `{"url": "https://example.com"}`)
+
+	// assert
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Contains(t, output, `"alias":"r3ady","short_url":"https://sho.rt/r3ady"`)
+}
+
+func TestCreateLinks(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache) {
 		ctrl := gomock.NewController(t)
-		database := mocksFetch.NewMockdatabase(ctrl)
-		cache := mocksFetch.NewMockcache(ctrl)
+		database := mocksCreate.NewMockdatabase(ctrl)
+		cache := mocksCreate.NewMockcache(ctrl)
 		return ctrl, database, cache
 	}
 
 	testCases := []struct {
-		name       string
-		alias      string
-		wantStatus int
-		wantOutput string
-		setupMock  func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache)
+		name        string
+		config      ucCreate.Config
+		input       string
+		wantStatus  int
+		wantOutputs []string
+		setupMock   func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache)
 	}{
 		{
-			name:       "Happy path",
-			alias:      "alias1",
-			wantStatus: http.StatusOK,
-			wantOutput: `{"url":"https://example.com","alias":"alias1","expired_at":"0001-01-01T00:00:00Z"}`,
-			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				link := entity.Link{URL: "https://example.com", Alias: "alias1", ExpiredAt: time.Time{}}
-				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(nil, entity.ErrNotFound).Times(1)
-				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
-				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			name:        "Happy path",
+			input:       `{"links": [{"url": "https://example.com"}, {"url": "https://example.org", "alias": "promo-2026"}]}`,
+			wantStatus:  http.StatusOK,
+			wantOutputs: []string{`"created":2,"failed":0`, `{"index":1,"link":{"url":"https://example.org","alias":"promo-2026"`},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Len(2)).Return([]bool{true, true}, nil).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
-			name:       "Happy path with cached link",
-			alias:      `alias2`,
+			name: "Invalid and blocked links don't fail the batch",
+			input: `{"links": [{"url": "ftp://example.com"}, {"url": "https://evil.com"}, {"url": "https://example.com", "alias": "x"},
+				{"url": "https://example.com"}]}`,
 			wantStatus: http.StatusOK,
-			wantOutput: `{"url":"https://example.com","alias":"alias2","expired_at":"0001-01-01T00:00:00Z"}`,
-			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				cachedLink := entity.Link{URL: "https://example.com", Alias: "alias2", ExpiredAt: time.Time{}}
-				cache.EXPECT().GetLink(gomock.Any(), "alias2").Return(&cachedLink, nil).Times(1)
+			wantOutputs: []string{
+				`"created":1,"failed":3`,
+				`{"index":0,"error":"validation error: url`,
+				`{"index":1,"error":"url is blocked"}`,
+				`{"index":2,"error":"validation error: alias`,
+				`{"index":3,"link":{"url":"https://example.com"`,
+			},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Len(1)).Return([]bool{true}, nil).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
-			name:       "Link not found",
-			alias:      "unknown",
-			wantStatus: http.StatusNotFound,
-			wantOutput: `not found`,
-			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				cache.EXPECT().GetLink(gomock.Any(), "unknown").Return(nil, entity.ErrNotFound).Times(1)
-				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			name:        "Custom alias already taken",
+			input:       `{"links": [{"url": "https://example.com", "alias": "promo-2026"}, {"url": "https://example.org"}]}`,
+			wantStatus:  http.StatusOK,
+			wantOutputs: []string{`"created":1,"failed":1`, `{"index":0,"error":"alias already exists"}`},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Len(2)).Return([]bool{false, true}, nil).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
-			name:       "Internal error",
-			alias:      "alias3",
-			wantStatus: http.StatusInternalServerError,
-			wantOutput: `internal error`,
-			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				cache.EXPECT().GetLink(gomock.Any(), "alias3").Return(nil, errors.New("test cache error")).Times(1)
-				database.EXPECT().FindLink(gomock.Any(), "alias3", "").Return(nil, errors.New("test db error")).Times(1)
+			name:        "Taken generated alias is retried",
+			input:       `{"links": [{"url": "https://example.com"}, {"url": "https://example.org"}]}`,
+			wantStatus:  http.StatusOK,
+			wantOutputs: []string{`"created":2,"failed":0`},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				first := database.EXPECT().CreateLinks(gomock.Any(), gomock.Len(2)).Return([]bool{true, false}, nil).Times(1)
+				retry := gomock.Cond(func(links []entity.Link) bool { return len(links) == 1 && links[0].URL == "https://example.org" })
+				database.EXPECT().CreateLinks(gomock.Any(), retry).Return([]bool{true}, nil).After(first).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
-			name:       "Validation error",
-			alias:      "a",
-			wantStatus: http.StatusBadRequest,
-			wantOutput: `validation error`,
+			name:        "Dedup: already shortened URL is returned but not counted",
+			config:      ucCreate.Config{Dedup: true},
+			input:       `{"links": [{"url": "https://example.com"}, {"url": "https://example.org", "password": "secret"}]}`,
+			wantStatus:  http.StatusOK,
+			wantOutputs: []string{`"created":1,"failed":0`, `{"index":0,"link":{"url":"https://example.com","alias":"existing"`, `"existing":true`},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				existing := entity.Link{URL: "https://example.com", Alias: "existing"}
+				database.EXPECT().FindOrCreateLink(gomock.Any(), gomock.Any()).Return(existing, entity.ErrAlreadyExist).Times(1)
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Len(1)).Return([]bool{true}, nil).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Validation error: too many passwords",
+			input: `{"links": [` + strings.Repeat(`{"url": "https://example.com", "password": "secret"},`, dto.MaxBatchPasswords) +
+				`{"url": "https://example.org", "password": "secret"}]}`,
+			wantStatus:  http.StatusBadRequest,
+			wantOutputs: []string{"validation error"},
+		},
+		{
+			name:        "Empty batch",
+			input:       `{"links": []}`,
+			wantStatus:  http.StatusBadRequest,
+			wantOutputs: []string{"validation error"},
+		},
+		{
+			name:        "Invalid json",
+			input:       `{"links": {}}`,
+			wantStatus:  http.StatusBadRequest,
+			wantOutputs: []string{"invalid json"},
+		},
+		{
+			name:        "Database error",
+			input:       `{"links": [{"url": "https://example.com"}]}`,
+			wantStatus:  http.StatusInternalServerError,
+			wantOutputs: []string{"internal error"},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Any()).Return(nil, errors.New("test error")).Times(1)
+			},
+		},
+		{
+			name:        "Cache error",
+			input:       `{"links": [{"url": "https://example.com"}]}`,
+			wantStatus:  http.StatusInternalServerError,
+			wantOutputs: []string{"internal error"},
+			setupMock: func(database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				database.EXPECT().CreateLinks(gomock.Any(), gomock.Any()).Return([]bool{true}, nil).Times(1)
+				cache.EXPECT().PutLinks(gomock.Any(), gomock.Any()).Return(errors.New("test error")).Times(1)
+			},
 		},
 	}
 
@@ -182,30 +513,28 @@ func TestFetchLink(t *testing.T) {
 			}
 
 			// arrange
-			uc := ucFetch.New(database, cache)
+			uc := ucCreate.New(tc.config, database, cache, adapterAlias.NewUUID(), shortURL, blocklist)
 
 			srv := fiber.New()
-			srv.Add(http.MethodGet, "/fetch/:alias", NewHandlerFetchLink(uc).Handler)
+			srv.Add(http.MethodPost, "/create", NewHandlerCreateLinks(uc).Handler)
 
 			// act
-			resp, output := sendHTTPRequest(t, srv, http.MethodGet, "/fetch/"+tc.alias, "")
+			resp, output := sendHTTPRequest(t, srv, http.MethodPost, "/create", tc.input)
 
 			// assert
-			if tc.wantStatus != 0 {
-				assert.Equal(t, tc.wantStatus, resp.StatusCode)
-			}
-			if tc.wantOutput != "" {
-				assert.Equal(t, tc.wantOutput, output)
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			for _, want := range tc.wantOutputs {
+				assert.Contains(t, output, want)
 			}
 		})
 	}
 }
 
-func TestRedirect(t *testing.T) {
-	initMock := func() (*gomock.Controller, *mocksFetch.Mockdatabase, *mocksFetch.Mockcache) {
+func TestCheckAlias(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache) {
 		ctrl := gomock.NewController(t)
-		database := mocksFetch.NewMockdatabase(ctrl)
-		cache := mocksFetch.NewMockcache(ctrl)
+		database := mocksCreate.NewMockdatabase(ctrl)
+		cache := mocksCreate.NewMockcache(ctrl)
 		return ctrl, database, cache
 	}
 
@@ -214,25 +543,25 @@ func TestRedirect(t *testing.T) {
 		alias      string
 		wantStatus int
 		wantOutput string
-		setupMock  func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache)
+		setupMock  func(database *mocksCreate.Mockdatabase)
 	}{
 		{
-			name:       "Happy path",
-			alias:      "alias1",
-			wantStatus: http.StatusFound,
-			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				link := entity.Link{URL: "https://example.com", Alias: "alias1", ExpiredAt: time.Time{}}
-				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			name:       "Alias is available",
+			alias:      "promo-2026",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"alias":"promo-2026","available":true}`,
+			setupMock: func(database *mocksCreate.Mockdatabase) {
+				database.EXPECT().FindLink(gomock.Any(), "promo-2026", "").Return(nil, entity.ErrNotFound).Times(1)
 			},
 		},
 		{
-			name:       "Link not found",
-			alias:      "unknown",
-			wantStatus: http.StatusNotFound,
-			wantOutput: `not found`,
-			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				cache.EXPECT().GetLink(gomock.Any(), "unknown").Return(nil, entity.ErrNotFound).Times(1)
-				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			name:       "Alias is taken",
+			alias:      "promo-2026",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"alias":"promo-2026","available":false}`,
+			setupMock: func(database *mocksCreate.Mockdatabase) {
+				link := entity.Link{URL: "https://example.com", Alias: "promo-2026"}
+				database.EXPECT().FindLink(gomock.Any(), "promo-2026", "").Return(&link, nil).Times(1)
 			},
 		},
 		{
@@ -241,14 +570,19 @@ func TestRedirect(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 			wantOutput: `validation error`,
 		},
+		{
+			name:       "Validation error: reserved alias",
+			alias:      "swagger",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
 		{
 			name:       "Internal error",
-			alias:      "alias3",
+			alias:      "promo-2026",
 			wantStatus: http.StatusInternalServerError,
 			wantOutput: `internal error`,
-			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
-				cache.EXPECT().GetLink(gomock.Any(), "alias3").Return(nil, errors.New("test cache error")).Times(1)
-				database.EXPECT().FindLink(gomock.Any(), "alias3", "").Return(nil, errors.New("test db error")).Times(1)
+			setupMock: func(database *mocksCreate.Mockdatabase) {
+				database.EXPECT().FindLink(gomock.Any(), "promo-2026", "").Return(nil, errors.New("test db error")).Times(1)
 			},
 		},
 	}
@@ -259,17 +593,17 @@ func TestRedirect(t *testing.T) {
 			defer ctrl.Finish()
 
 			if tc.setupMock != nil {
-				tc.setupMock(database, cache)
+				tc.setupMock(database)
 			}
 
 			// arrange
-			uc := ucFetch.New(database, cache)
+			uc := ucCreate.New(ucCreate.Config{}, database, cache, adapterAlias.NewUUID(), shortURL, blocklist)
 
 			srv := fiber.New()
-			srv.Add(http.MethodGet, "/fetch/:alias/redirect", NewHandlerRedirect(uc).Handler)
+			srv.Add(http.MethodGet, "/link/:alias/available", NewHandlerCheckAlias(uc).Handler)
 
 			// act
-			resp, output := sendHTTPRequest(t, srv, http.MethodGet, "/fetch/"+tc.alias+"/redirect", "")
+			resp, output := sendHTTPRequest(t, srv, http.MethodGet, "/link/"+tc.alias+"/available", "")
 
 			// assert
 			if tc.wantStatus != 0 {
@@ -282,6 +616,1137 @@ func TestRedirect(t *testing.T) {
 	}
 }
 
+func TestFetchLink(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksFetch.Mockdatabase, *mocksFetch.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksFetch.NewMockdatabase(ctrl)
+		cache := mocksFetch.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	testCases := []struct {
+		name       string
+		alias      string
+		wantStatus int
+		wantOutput string
+		setupMock  func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache)
+	}{
+		{
+			name:       "Happy path",
+			alias:      "alias1",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"url":"https://example.com","alias":"alias1","short_url":"https://sho.rt/alias1","expired_at":null,"redirect_code":302,"forward_query":false,"password_protected":false,"max_clicks":0}`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", ExpiredAt: time.Time{}}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(nil, entity.ErrNotFound).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with expiration",
+			alias:      "alias4",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"url":"https://example.com","alias":"alias4","short_url":"https://sho.rt/alias4","expired_at":"2100-01-01T00:00:00Z","redirect_code":302,"forward_query":false,"password_protected":false,"max_clicks":0}`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias4", ExpiredAt: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}
+				cache.EXPECT().GetLink(gomock.Any(), "alias4").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with cached link",
+			alias:      `alias2`,
+			wantStatus: http.StatusOK,
+			wantOutput: `{"url":"https://example.com","alias":"alias2","short_url":"https://sho.rt/alias2","expired_at":null,"redirect_code":302,"forward_query":false,"password_protected":false,"max_clicks":0}`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cachedLink := entity.Link{URL: "https://example.com", Alias: "alias2", ExpiredAt: time.Time{}}
+				cache.EXPECT().GetLink(gomock.Any(), "alias2").Return(&cachedLink, nil).Times(1)
+			},
+		},
+		{
+			name:       "Protected link hides the URL",
+			alias:      "alias6",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"url":"","alias":"alias6","short_url":"https://sho.rt/alias6","expired_at":null,"redirect_code":302,"forward_query":false,"password_protected":true,"max_clicks":0}`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias6", PasswordHash: "hash"}
+				cache.EXPECT().GetLink(gomock.Any(), "alias6").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link expired",
+			alias:      "alias5",
+			wantStatus: http.StatusGone,
+			wantOutput: `link expired`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias5", ExpiredAt: time.Now().Add(-time.Minute)}
+				cache.EXPECT().GetLink(gomock.Any(), "alias5").Return(nil, entity.ErrNotFound).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "alias5", "").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			alias:      "unknown",
+			wantStatus: http.StatusNotFound,
+			wantOutput: `not found`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "unknown").Return(nil, entity.ErrNotFound).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Internal error",
+			alias:      "alias3",
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: `internal error`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "alias3").Return(nil, errors.New("test cache error")).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "alias3", "").Return(nil, errors.New("test db error")).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			alias:      "a",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			uc := ucFetch.New(ucFetch.Config{}, database, cache, shortURL)
+
+			srv := fiber.New()
+			srv.Add(http.MethodGet, "/fetch/:alias", NewHandlerFetchLink(uc).Handler)
+
+			// act
+			resp, output := sendHTTPRequest(t, srv, http.MethodGet, "/fetch/"+tc.alias, "")
+
+			// assert
+			if tc.wantStatus != 0 {
+				assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			}
+			if tc.wantOutput != "" {
+				assert.Equal(t, tc.wantOutput, output)
+			}
+		})
+	}
+}
+
+func TestRedirect(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksFetch.Mockdatabase, *mocksFetch.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksFetch.NewMockdatabase(ctrl)
+		cache := mocksFetch.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	testCases := []struct {
+		name             string
+		alias            string
+		query            string
+		wantStatus       int
+		wantOutput       string
+		wantLocation     string
+		config           ucFetch.Config
+		wantCacheControl string // regexp
+		wantClick        bool
+		setupMock        func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache)
+	}{
+		{
+			name:             "Happy path",
+			alias:            "alias1",
+			query:            "?utm_source=ads",
+			wantStatus:       http.StatusFound,
+			wantLocation:     "https://example.com",
+			wantCacheControl: `^private, no-store$`,
+			wantClick:        true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", ExpiredAt: time.Time{}}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:             "Permanent redirect of never expiring link",
+			alias:            "alias1",
+			config:           ucFetch.Config{RedirectMaxAge: 5 * time.Minute},
+			wantStatus:       http.StatusMovedPermanently,
+			wantLocation:     "https://example.com",
+			wantCacheControl: `^private, max-age=300$`,
+			wantClick:        true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", RedirectCode: http.StatusMovedPermanently}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:             "Permanent redirect is cached until the link expires",
+			alias:            "alias1",
+			config:           ucFetch.Config{RedirectMaxAge: 5 * time.Minute},
+			wantStatus:       http.StatusPermanentRedirect,
+			wantLocation:     "https://example.com",
+			wantCacheControl: `^private, max-age=(119|120)$`,
+			wantClick:        true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{
+					URL: "https://example.com", Alias: "alias1", ExpiredAt: time.Now().Add(2 * time.Minute), RedirectCode: http.StatusPermanentRedirect,
+				}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:             "Permanent redirect is not cached without max age",
+			alias:            "alias1",
+			wantStatus:       http.StatusMovedPermanently,
+			wantLocation:     "https://example.com",
+			wantCacheControl: `^private, no-store$`,
+			wantClick:        true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", RedirectCode: http.StatusMovedPermanently}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:             "Query string is merged into the URL",
+			alias:            "alias1",
+			query:            "?utm_source=ads&utm_medium=cpc&gclid=abc",
+			wantStatus:       http.StatusTemporaryRedirect,
+			wantLocation:     "https://example.com/path?utm_source=site&gclid=abc&utm_medium=cpc#top",
+			wantCacheControl: `^private, no-store$`,
+			wantClick:        true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{
+					URL: "https://example.com/path?utm_source=site#top", Alias: "alias1", RedirectCode: http.StatusTemporaryRedirect, ForwardQuery: true,
+				}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:             "Limited link consumes a click",
+			alias:            "alias1",
+			wantStatus:       http.StatusMovedPermanently,
+			wantLocation:     "https://example.com",
+			wantCacheControl: `^private, no-store$`,
+			wantClick:        true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", RedirectCode: http.StatusMovedPermanently, MaxClicks: 3}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(1, nil).Times(1)
+				database.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(1, nil).Times(1)
+			},
+		},
+		{
+			name:         "Limited link puts the missing click counter",
+			alias:        "alias1",
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://example.com",
+			wantClick:    true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", MaxClicks: 1}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(0, entity.ErrNotFound).Times(1)
+				database.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(0, nil).Times(1)
+				cache.EXPECT().PutClicksLeft(gomock.Any(), link, 0).Return(nil).Times(1)
+			},
+		},
+		{
+			name:         "Limited link falls back to the database",
+			alias:        "alias1",
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://example.com",
+			wantClick:    true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", MaxClicks: 5}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(0, errors.New("test cache error")).Times(1)
+				database.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(4, nil).Times(1)
+			},
+		},
+		{
+			name:       "Limited link refunds the cached click on database error",
+			alias:      "alias1",
+			wantStatus: http.StatusInternalServerError,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", MaxClicks: 3}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(1, nil).Times(1)
+				database.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(0, errors.New("test db error")).Times(1)
+				cache.EXPECT().RefundClick(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Limited link exhausted in cache",
+			alias:      "alias1",
+			wantStatus: http.StatusGone,
+			wantOutput: `link exhausted`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", MaxClicks: 1}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(0, entity.ErrExhausted).Times(1)
+			},
+		},
+		{
+			name:       "Limited link exhausted in database",
+			alias:      "alias1",
+			wantStatus: http.StatusGone,
+			wantOutput: `link exhausted`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", MaxClicks: 1}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(0, nil).Times(1)
+				database.EXPECT().ConsumeClick(gomock.Any(), "alias1").Return(0, entity.ErrExhausted).Times(1)
+			},
+		},
+		{
+			name:       "Blocked link",
+			alias:      "alias1",
+			wantStatus: http.StatusGone,
+			wantOutput: `link blocked`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://evil.com", Alias: "alias1", Blocked: true}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:             "Protected link asks for the password",
+			alias:            "alias1",
+			wantStatus:       http.StatusOK,
+			wantCacheControl: `^private, no-store$`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1", PasswordHash: "hash"}
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Cached link expired",
+			alias:      "alias2",
+			wantStatus: http.StatusGone,
+			wantOutput: `link expired`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias2", ExpiredAt: time.Now().Add(-time.Minute)}
+				cache.EXPECT().GetLink(gomock.Any(), "alias2").Return(&link, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			alias:      "unknown",
+			wantStatus: http.StatusNotFound,
+			wantOutput: `not found`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "unknown").Return(nil, entity.ErrNotFound).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			alias:      "a",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Internal error",
+			alias:      "alias3",
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: `internal error`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "alias3").Return(nil, errors.New("test cache error")).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "alias3", "").Return(nil, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			tracker := mocksHTTP.NewMockclickTracker(ctrl)
+			if tc.wantClick {
+				click := gomock.Cond(func(i dto.RecordClickInput) bool {
+					return i.Alias == tc.alias && i.Referrer == "https://referrer.com" && !i.ClickedAt.IsZero()
+				})
+				tracker.EXPECT().Track(click).Times(1)
+			}
+
+			// arrange
+			uc := ucFetch.New(tc.config, database, cache, shortURL)
+
+			srv := fiber.New()
+			srv.Add(http.MethodGet, "/fetch/:alias/redirect", NewHandlerRedirect(uc, tracker).Handler)
+
+			// act
+			req := httptest.NewRequest(http.MethodGet, "/fetch/"+tc.alias+"/redirect"+tc.query, http.NoBody)
+			req.Header.Set(fiber.HeaderReferer, "https://referrer.com")
+			resp, err := srv.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			output := string(body)
+
+			// assert
+			if tc.wantStatus != 0 {
+				assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			}
+			if tc.wantOutput != "" {
+				assert.Equal(t, tc.wantOutput, output)
+			}
+			if tc.wantLocation != "" {
+				assert.Equal(t, tc.wantLocation, resp.Header.Get(fiber.HeaderLocation))
+			}
+			if tc.wantCacheControl != "" {
+				assert.Regexp(t, tc.wantCacheControl, resp.Header.Get(fiber.HeaderCacheControl))
+			}
+		})
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksFetch.Mockdatabase, *mocksFetch.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksFetch.NewMockdatabase(ctrl)
+		cache := mocksFetch.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	hash, err := entity.HashPassword("secret")
+	require.NoError(t, err)
+	link := entity.Link{
+		URL: "https://example.com", Alias: "alias1", RedirectCode: http.StatusMovedPermanently, ForwardQuery: true, PasswordHash: hash,
+	}
+
+	testCases := []struct {
+		name         string
+		alias        string
+		password     string
+		wantStatus   int
+		wantOutput   string // regexp
+		wantLocation string
+		wantClick    bool
+		setupMock    func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache)
+	}{
+		{
+			name:         "Happy path",
+			alias:        "alias1",
+			password:     "secret",
+			wantStatus:   http.StatusSeeOther,
+			wantLocation: "https://example.com?utm_source=ads",
+			wantClick:    true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().AddPasswordAttempt(gomock.Any(), "alias1", "0.0.0.0", 15*time.Minute).Return(1, nil).Times(1)
+				cache.EXPECT().ResetPasswordAttempts(gomock.Any(), "alias1", "0.0.0.0").Return(nil).Times(1)
+			},
+		},
+		{
+			name:         "Throttling fails open",
+			alias:        "alias1",
+			password:     "secret",
+			wantStatus:   http.StatusSeeOther,
+			wantLocation: "https://example.com?utm_source=ads",
+			wantClick:    true,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().AddPasswordAttempt(gomock.Any(), "alias1", "0.0.0.0", 15*time.Minute).Return(0, errors.New("test cache error")).Times(1)
+				cache.EXPECT().ResetPasswordAttempts(gomock.Any(), "alias1", "0.0.0.0").Return(errors.New("test cache error")).Times(1)
+			},
+		},
+		{
+			name:       "Wrong password",
+			alias:      "alias1",
+			password:   "wrong",
+			wantStatus: http.StatusUnauthorized,
+			wantOutput: `Wrong password`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().AddPasswordAttempt(gomock.Any(), "alias1", "0.0.0.0", 15*time.Minute).Return(5, nil).Times(1)
+			},
+		},
+		{
+			name:       "Too many attempts",
+			alias:      "alias1",
+			password:   "secret",
+			wantStatus: http.StatusTooManyRequests,
+			wantOutput: `Too many wrong passwords`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "alias1").Return(&link, nil).Times(1)
+				cache.EXPECT().AddPasswordAttempt(gomock.Any(), "alias1", "0.0.0.0", 15*time.Minute).Return(6, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			alias:      "unknown",
+			password:   "secret",
+			wantStatus: http.StatusNotFound,
+			wantOutput: `not found`,
+			setupMock: func(database *mocksFetch.Mockdatabase, cache *mocksFetch.Mockcache) {
+				cache.EXPECT().GetLink(gomock.Any(), "unknown").Return(nil, entity.ErrNotFound).Times(1)
+				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			alias:      "a",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			tracker := mocksHTTP.NewMockclickTracker(ctrl)
+			if tc.wantClick {
+				tracker.EXPECT().Track(gomock.Cond(func(i dto.RecordClickInput) bool { return i.Alias == tc.alias })).Times(1)
+			}
+
+			// arrange
+			uc := ucFetch.New(ucFetch.Config{}, database, cache, shortURL)
+
+			srv := fiber.New()
+			srv.Add(http.MethodPost, "/fetch/:alias/redirect", NewHandlerRedirect(uc, tracker).Unlock)
+
+			// act
+			form := url.Values{"password": {tc.password}}
+			req := httptest.NewRequest(http.MethodPost, "/fetch/"+tc.alias+"/redirect?utm_source=ads", strings.NewReader(form.Encode()))
+			req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationForm)
+			resp, err := srv.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			// assert
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantOutput != "" {
+				assert.Regexp(t, tc.wantOutput, string(body))
+			}
+			if tc.wantLocation != "" {
+				assert.Equal(t, tc.wantLocation, resp.Header.Get(fiber.HeaderLocation))
+				assert.Equal(t, "private, no-store", resp.Header.Get(fiber.HeaderCacheControl))
+			}
+		})
+	}
+}
+
+func TestUpdateLink(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksUpdate.Mockdatabase, *mocksUpdate.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksUpdate.NewMockdatabase(ctrl)
+		cache := mocksUpdate.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	expiredAt := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		lifetime   entity.Lifetime
+		alias      string
+		input      string
+		wantStatus int
+		wantOutput string
+		setupMock  func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache)
+	}{
+		{
+			name:       "URL is blocked",
+			alias:      "alias1",
+			input:      `{"url": "https://evil.com/login"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantOutput: `url is blocked`,
+		},
+		{
+			name:       "Happy path with new URL",
+			alias:      "alias1",
+			input:      `{"url": "https://example.org"}`,
+			wantStatus: http.StatusOK,
+			wantOutput: `{"url":"https://example.org","alias":"alias1","short_url":"https://sho.rt/alias1","expired_at":"2100-01-01T00:00:00Z","redirect_code":302,"forward_query":false,"password_protected":false,"max_clicks":0}`,
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				updated := entity.Link{URL: "https://example.org", Alias: "alias1", ExpiredAt: expiredAt}
+				changes := entity.LinkChanges{URL: "https://example.org"}
+				database.EXPECT().UpdateLink(gomock.Any(), "alias1", changes, uuid.Nil).Return(updated, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path with never expiring link",
+			alias:      "alias1",
+			input:      `{"never_expires": true}`,
+			wantStatus: http.StatusOK,
+			wantOutput: `{"url":"https://example.com","alias":"alias1","short_url":"https://sho.rt/alias1","expired_at":null,"redirect_code":302,"forward_query":false,"password_protected":false,"max_clicks":0}`,
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				updated := entity.Link{URL: "https://example.com", Alias: "alias1"}
+				changes := entity.LinkChanges{SetExpiry: true}
+				database.EXPECT().UpdateLink(gomock.Any(), "alias1", changes, uuid.Nil).Return(updated, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Expiration exceeds max TTL",
+			lifetime:   entity.Lifetime{MaxTTL: time.Hour},
+			alias:      "alias1",
+			input:      `{"expires_in": 7200}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Link not found",
+			alias:      "unknown",
+			input:      `{"url": "https://example.org"}`,
+			wantStatus: http.StatusNotFound,
+			wantOutput: `not found`,
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				database.EXPECT().UpdateLink(gomock.Any(), "unknown", gomock.Any(), uuid.Nil).Return(entity.Link{}, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Cache error is ignored",
+			alias:      "alias2",
+			input:      `{"never_expires": true}`,
+			wantStatus: http.StatusOK,
+			wantOutput: `{"url":"https://example.com","alias":"alias2","short_url":"https://sho.rt/alias2","expired_at":null,"redirect_code":302,"forward_query":false,"password_protected":false,"max_clicks":0}`,
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				updated := entity.Link{URL: "https://example.com", Alias: "alias2"}
+				database.EXPECT().UpdateLink(gomock.Any(), "alias2", gomock.Any(), uuid.Nil).Return(updated, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias2").Return(errors.New("test cache error")).Times(1)
+			},
+		},
+		{
+			name:       "Validation error: nothing to update",
+			alias:      "alias1",
+			input:      `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: expires_in overflow",
+			alias:      "alias1",
+			input:      `{"expires_in": 9223372036854775}`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Invalid json",
+			alias:      "alias1",
+			input:      `https://example.org`,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `invalid json`,
+		},
+		{
+			name:       "Internal error",
+			alias:      "alias2",
+			input:      `{"url": "https://example.org"}`,
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: `internal error`,
+			setupMock: func(database *mocksUpdate.Mockdatabase, cache *mocksUpdate.Mockcache) {
+				database.EXPECT().UpdateLink(gomock.Any(), "alias2", gomock.Any(), uuid.Nil).Return(entity.Link{}, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			uc := ucUpdate.New(tc.lifetime, database, cache, shortURL, blocklist)
+
+			srv := fiber.New()
+			srv.Add(http.MethodPatch, "/link/:alias", NewHandlerUpdateLink(uc).Handler)
+
+			// act
+			resp, output := sendHTTPRequest(t, srv, http.MethodPatch, "/link/"+tc.alias, tc.input)
+
+			// assert
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantOutput != "" {
+				assert.Equal(t, tc.wantOutput, output)
+			}
+		})
+	}
+}
+
+func TestDeleteLink(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksDelete.Mockdatabase, *mocksDelete.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksDelete.NewMockdatabase(ctrl)
+		cache := mocksDelete.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	testCases := []struct {
+		name       string
+		alias      string
+		wantStatus int
+		wantOutput string
+		setupMock  func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache)
+	}{
+		{
+			name:       "Happy path",
+			alias:      "alias1",
+			wantStatus: http.StatusNoContent,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1"}
+				database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).Return(link, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			alias:      "unknown",
+			wantStatus: http.StatusNotFound,
+			wantOutput: `not found`,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				database.EXPECT().DeleteLink(gomock.Any(), "unknown", uuid.Nil).Return(entity.Link{}, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			alias:      "a",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Cache error is ignored",
+			alias:      "alias2",
+			wantStatus: http.StatusNoContent,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias2"}
+				database.EXPECT().DeleteLink(gomock.Any(), "alias2", uuid.Nil).Return(link, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias2").Return(errors.New("test cache error")).Times(1)
+			},
+		},
+		{
+			name:       "Internal error",
+			alias:      "alias2",
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: `internal error`,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				database.EXPECT().DeleteLink(gomock.Any(), "alias2", uuid.Nil).Return(entity.Link{}, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			uc := ucDelete.New(database, cache)
+
+			srv := fiber.New()
+			srv.Add(http.MethodDelete, "/link/:alias", NewHandlerDeleteLink(uc).Handler)
+
+			// act
+			resp, output := sendHTTPRequest(t, srv, http.MethodDelete, "/link/"+tc.alias, "")
+
+			// assert
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantOutput != "" {
+				assert.Equal(t, tc.wantOutput, output)
+			}
+		})
+	}
+}
+
+func TestLinkStats(t *testing.T) {
+	lastClickAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	firstClickAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		alias      string
+		query      string
+		wantStatus int
+		wantOutput string
+		setupMock  func(database *mocksStats.Mockdatabase)
+	}{
+		{
+			name:       "Happy path",
+			alias:      "alias1",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"alias":"alias1","total_clicks":3,"first_click_at":"2026-01-01T00:00:00Z","last_click_at":"2026-01-02T00:00:00Z","clicks_day":1,"clicks_week":2,"clicks_month":3,` +
+				`"countries":[{"value":"GB","clicks":2},{"value":"","clicks":1}],"referrers":[],"browsers":[],"os":[],"devices":[{"value":"mobile","clicks":3}]}`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias1"}
+				stats := entity.LinkStats{
+					TotalClicks: 3, FirstClickAt: firstClickAt, LastClickAt: lastClickAt, ClicksDay: 1, ClicksWeek: 2, ClicksMonth: 3,
+					Countries: []entity.StatsEntry{{Value: "GB", Clicks: 2}, {Value: "", Clicks: 1}},
+					Devices:   []entity.StatsEntry{{Value: entity.DeviceMobile, Clicks: 3}},
+				}
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetLinkStats(gomock.Any(), link.ID, gomock.Any(), 10).Return(stats, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link has never been clicked",
+			alias:      "alias2",
+			query:      "?top=5",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"alias":"alias2","total_clicks":0,"first_click_at":null,"last_click_at":null,"clicks_day":0,"clicks_week":0,"clicks_month":0,` +
+				`"countries":[],"referrers":[],"browsers":[],"os":[],"devices":[]}`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias2"}
+				database.EXPECT().FindLink(gomock.Any(), "alias2", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetLinkStats(gomock.Any(), link.ID, gomock.Any(), 5).Return(entity.LinkStats{}, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			alias:      "unknown",
+			wantStatus: http.StatusNotFound,
+			wantOutput: `not found`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Validation error",
+			alias:      "a",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: top out of range",
+			alias:      "alias1",
+			query:      "?top=1000",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Internal error",
+			alias:      "alias3",
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: `internal error`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias3"}
+				database.EXPECT().FindLink(gomock.Any(), "alias3", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetLinkStats(gomock.Any(), link.ID, gomock.Any(), 10).Return(entity.LinkStats{}, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksStats.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			srv := fiber.New()
+			srv.Add(http.MethodGet, "/link/:alias/stats", NewHandlerLinkStats(ucStats.New(database, nil, nil)).Handler)
+
+			// act
+			resp, output := sendHTTPRequest(t, srv, http.MethodGet, "/link/"+tc.alias+"/stats"+tc.query, "")
+
+			// assert
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			assert.Equal(t, tc.wantOutput, output)
+		})
+	}
+}
+
+func TestLinkTimeseries(t *testing.T) {
+	testCases := []struct {
+		name       string
+		alias      string
+		query      string
+		wantStatus int
+		wantOutput string
+		setupMock  func(database *mocksStats.Mockdatabase)
+	}{
+		{
+			name:       "Happy path: days in time zone",
+			alias:      "alias1",
+			query:      "?from=2026-01-01T00:00:00%2B03:00&to=2026-01-04T00:00:00%2B03:00&interval=day&tz=Europe/Moscow",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"alias":"alias1","interval":"day","tz":"Europe/Moscow","buckets":[` +
+				`{"start":"2026-01-01T00:00:00+03:00","clicks":2},{"start":"2026-01-02T00:00:00+03:00","clicks":4},{"start":"2026-01-03T00:00:00+03:00","clicks":0}]}`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias1"}
+				hourly := []entity.ClickBucket{
+					{Start: time.Date(2025, 12, 31, 21, 0, 0, 0, time.UTC), Clicks: 2},
+					{Start: time.Date(2026, 1, 1, 22, 0, 0, 0, time.UTC), Clicks: 3},
+					{Start: time.Date(2026, 1, 2, 20, 0, 0, 0, time.UTC), Clicks: 1},
+				}
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetClickHistogram(gomock.Any(), link.ID, gomock.Any(), gomock.Any()).Return(hourly, nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path: weeks start on Monday",
+			alias:      "alias1",
+			query:      "?from=2026-01-07T00:00:00Z&to=2026-01-19T00:00:00Z&interval=week",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"alias":"alias1","interval":"week","tz":"UTC","buckets":[` +
+				`{"start":"2026-01-05T00:00:00Z","clicks":1},{"start":"2026-01-12T00:00:00Z","clicks":5}]}`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias1"}
+				hourly := []entity.ClickBucket{
+					{Start: time.Date(2026, 1, 11, 23, 0, 0, 0, time.UTC), Clicks: 1},
+					{Start: time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), Clicks: 5},
+				}
+				from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+				to := time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)
+				database.EXPECT().FindLink(gomock.Any(), "alias1", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetClickHistogram(gomock.Any(), link.ID, from, to).Return(hourly, nil).Times(1)
+			},
+		},
+		{
+			name:       "Link not found",
+			alias:      "unknown",
+			query:      "?from=2026-01-01T00:00:00Z&to=2026-01-02T00:00:00Z&interval=hour",
+			wantStatus: http.StatusNotFound,
+			wantOutput: `not found`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().FindLink(gomock.Any(), "unknown", "").Return(nil, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:       "Validation error: invalid from",
+			alias:      "alias1",
+			query:      "?from=yesterday&to=2026-01-02T00:00:00Z&interval=hour",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: unknown interval",
+			alias:      "alias1",
+			query:      "?from=2026-01-01T00:00:00Z&to=2026-01-02T00:00:00Z&interval=minute",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: too many buckets",
+			alias:      "alias1",
+			query:      "?from=2026-01-01T00:00:00Z&to=2026-03-01T00:00:00Z&interval=hour",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: unknown time zone",
+			alias:      "alias1",
+			query:      "?from=2026-01-01T00:00:00Z&to=2026-01-02T00:00:00Z&interval=hour&tz=Mars/Olympus",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Internal error",
+			alias:      "alias3",
+			query:      "?from=2026-01-01T00:00:00Z&to=2026-01-02T00:00:00Z&interval=hour",
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: `internal error`,
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				link := entity.Link{Alias: "alias3"}
+				database.EXPECT().FindLink(gomock.Any(), "alias3", "").Return(&link, nil).Times(1)
+				database.EXPECT().GetClickHistogram(gomock.Any(), link.ID, gomock.Any(), gomock.Any()).Return(nil, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksStats.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			srv := fiber.New()
+			srv.Add(http.MethodGet, "/link/:alias/stats/timeseries", NewHandlerLinkTimeseries(ucStats.New(database, nil, nil)).Handler)
+
+			// act
+			resp, output := sendHTTPRequest(t, srv, http.MethodGet, "/link/"+tc.alias+"/stats/timeseries"+tc.query, "")
+
+			// assert
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			assert.Equal(t, tc.wantOutput, output)
+		})
+	}
+}
+
+func TestListLinks(t *testing.T) {
+	id1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	id2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	id3 := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	owner := uuid.MustParse("00000000-0000-0000-0000-0000000000aa")
+	day1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	day3 := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	// next_cursor of the first page of links created before the 3rd link
+	cursor := "eyJzIjoiY3JlYXRlZF9hdCIsImQiOnRydWUsInQiOiIyMDI2LTAxLTAyVDAwOjAwOjAwWiIsImlkIjoiMDAwMDAwMDAtMDAwMC0wMDAwLTAwMDAtMDAwMDAwMDAwMDAyIn0"
+
+	testCases := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantOutput string
+		setupMock  func(database *mocksList.Mockdatabase)
+	}{
+		{
+			name:       "Happy path: first page",
+			query:      "?limit=2",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"links":[` +
+				`{"url":"https://example.com/3","alias":"alias3","short_url":"https://sho.rt/alias3","expired_at":null,"redirect_code":302,` +
+				`"forward_query":false,"password_protected":false,"max_clicks":0,"created_at":"2026-01-03T00:00:00Z"},` +
+				`{"url":"https://example.com/2","alias":"alias2","short_url":"https://sho.rt/alias2","expired_at":null,"redirect_code":302,` +
+				`"forward_query":false,"password_protected":false,"max_clicks":0,"created_at":"2026-01-02T00:00:00Z"}],` +
+				`"next_cursor":"` + cursor + `"}`,
+			setupMock: func(database *mocksList.Mockdatabase) {
+				filter := entity.LinkFilter{SortBy: entity.LinkSortCreatedAt, Desc: true, Limit: 3}
+				links := []entity.Link{
+					{ID: id3, URL: "https://example.com/3", Alias: "alias3", CreatedAt: day3},
+					{ID: id2, URL: "https://example.com/2", Alias: "alias2", CreatedAt: day2},
+					{ID: id1, URL: "https://example.com/1", Alias: "alias1", CreatedAt: day1},
+				}
+				database.EXPECT().ListLinks(gomock.Any(), filter).Return(links, nil).Times(1)
+			},
+		},
+		{
+			name: "Happy path: last page with filters",
+			query: "?limit=2&cursor=" + cursor + "&owner_id=" + owner.String() +
+				"&created_from=2026-01-01T00:00:00Z&created_to=2026-02-01T00:00:00Z&state=active&domain=Example",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"links":[` +
+				`{"url":"","alias":"alias1","short_url":"https://sho.rt/alias1","expired_at":null,"redirect_code":302,` +
+				`"forward_query":false,"password_protected":true,"max_clicks":0,"created_at":"2026-01-01T00:00:00Z"}]}`,
+			setupMock: func(database *mocksList.Mockdatabase) {
+				filter := entity.LinkFilter{
+					OwnerID:     owner,
+					CreatedFrom: day1,
+					CreatedTo:   time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+					State:       entity.LinkStateActive,
+					Domain:      "Example",
+					SortBy:      entity.LinkSortCreatedAt,
+					Desc:        true,
+					After:       &entity.LinkCursor{Time: day2, ID: id2},
+					Limit:       3,
+				}
+				links := []entity.Link{
+					{ID: id1, URL: "https://example.com/1", Alias: "alias1", CreatedAt: day1, PasswordHash: "hash", OwnerID: owner},
+				}
+				database.EXPECT().ListLinks(gomock.Any(), filter).Return(links, nil).Times(1)
+			},
+		},
+		{
+			name:       "Happy path: links that never expire go last",
+			query:      "?sort=expired_at&order=asc",
+			wantStatus: http.StatusOK,
+			wantOutput: `{"links":[]}`,
+			setupMock: func(database *mocksList.Mockdatabase) {
+				filter := entity.LinkFilter{SortBy: entity.LinkSortExpiredAt, Limit: 51}
+				database.EXPECT().ListLinks(gomock.Any(), filter).Return(nil, nil).Times(1)
+			},
+		},
+		{
+			name:       "Validation error: unknown state",
+			query:      "?state=deleted",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: invalid owner",
+			query:      "?owner_id=me",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: invalid date",
+			query:      "?created_from=yesterday",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: limit out of range",
+			query:      "?limit=1000",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: invalid cursor",
+			query:      "?cursor=garbage",
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Validation error: cursor of another sort",
+			query:      "?sort=expired_at&cursor=" + cursor,
+			wantStatus: http.StatusBadRequest,
+			wantOutput: `validation error`,
+		},
+		{
+			name:       "Internal error",
+			wantStatus: http.StatusInternalServerError,
+			wantOutput: `internal error`,
+			setupMock: func(database *mocksList.Mockdatabase) {
+				database.EXPECT().ListLinks(gomock.Any(), gomock.Any()).Return(nil, errors.New("test db error")).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksList.NewMockdatabase(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			srv := fiber.New()
+			srv.Add(http.MethodGet, "/links", NewHandlerListLinks(ucList.New(database, shortURL)).Handler)
+
+			// act
+			resp, output := sendHTTPRequest(t, srv, http.MethodGet, "/links"+tc.query, "")
+
+			// assert
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			assert.Equal(t, tc.wantOutput, output)
+		})
+	}
+}
+
 func sendHTTPRequest(test *testing.T, app *fiber.App, method, url, body string) (resp *http.Response, respBody string) {
 	req := httptest.NewRequest(method, url, bytes.NewBuffer([]byte(body)))
 	req.Header.Set("Content-Type", "application/json")
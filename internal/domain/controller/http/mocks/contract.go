@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_http is a generated GoMock package.
+package mock_http
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	dto "github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+)
+
+// MockclickTracker is a mock of clickTracker interface.
+type MockclickTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockclickTrackerMockRecorder
+	isgomock struct{}
+}
+
+// MockclickTrackerMockRecorder is the mock recorder for MockclickTracker.
+type MockclickTrackerMockRecorder struct {
+	mock *MockclickTracker
+}
+
+// NewMockclickTracker creates a new mock instance.
+func NewMockclickTracker(ctrl *gomock.Controller) *MockclickTracker {
+	mock := &MockclickTracker{ctrl: ctrl}
+	mock.recorder = &MockclickTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclickTracker) EXPECT() *MockclickTrackerMockRecorder {
+	return m.recorder
+}
+
+// Track mocks base method.
+func (m *MockclickTracker) Track(input dto.RecordClickInput) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Track", input)
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockclickTrackerMockRecorder) Track(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockclickTracker)(nil).Track), input)
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"bytes"
+	"html/template"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// passwordPrompt is served instead of the redirect for password-protected links.
+// The form posts to the page URL, so the query string is kept for forward_query links.
+var passwordPrompt = template.Must(template.New("password").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Password required</title>
+</head>
+<body>
+<form method="post">
+<p>The link <b>{{.Alias}}</b> is protected with a password.</p>
+{{if .Error}}<p role="alert">{{.Error}}</p>{{end}}
+<input type="password" name="password" autocomplete="current-password" required autofocus>
+<button type="submit">Open</button>
+</form>
+</body>
+</html>
+`))
+
+type passwordPromptData struct {
+	Alias string
+	Error string
+}
+
+// renderPasswordPrompt responds with the password form, the page must not be cached or framed.
+func renderPasswordPrompt(c *fiber.Ctx, status int, data passwordPromptData) error {
+	var buf bytes.Buffer
+	if err := passwordPrompt.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	c.Set(fiber.HeaderXFrameOptions, "DENY")
+	c.Type("html", "utf-8")
+
+	return c.Status(status).Send(buf.Bytes())
+}
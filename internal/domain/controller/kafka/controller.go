@@ -3,24 +3,75 @@ package kafka
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/segmentio/kafka-go"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/backoff"
 )
 
+// HeaderCommand is the message header selecting the command, messages without it create a link.
+const HeaderCommand = "command"
+
+const (
+	CommandCreate = "create"
+	CommandDelete = "delete"
+)
+
+const (
+	retryAttempts   = 5
+	retryMinBackoff = 100 * time.Millisecond
+	retryMaxBackoff = 10 * time.Second
+)
+
+var (
+	// errMalformed marks the messages that cannot be decoded or validated.
+	errMalformed = errors.New("malformed message")
+	// errConflict marks the messages requesting a custom alias another link already uses.
+	errConflict = errors.New("conflicting message")
+)
+
+type Config struct {
+	// RetryAttempts limits the attempts to handle a message failing with a transient error,
+	// each retry waits twice as long as the previous one, from RetryMinBackoff up to RetryMaxBackoff
+	RetryAttempts   int           `env:"KAFKA_RETRY_ATTEMPTS, default=5"`
+	RetryMinBackoff time.Duration `env:"KAFKA_RETRY_MIN_BACKOFF, default=100ms"`
+	RetryMaxBackoff time.Duration `env:"KAFKA_RETRY_MAX_BACKOFF, default=10s"`
+}
+
 type Consumer struct {
-	kafka kafkaReader
-	uc    create.Usecase
+	attempts   int
+	backoff    backoff.Exponential
+	kafka      kafkaReader
+	deadLetter kafkaWriter
+	ucCreate   create.Usecase
+	ucDelete   remove.Usecase
 }
 
-func New(k kafkaReader, uc create.Usecase) *Consumer {
-	return &Consumer{kafka: k, uc: uc}
+// New returns the consumer of the commands, the messages that cannot be handled are written to deadLetter.
+func New(c Config, k kafkaReader, deadLetter kafkaWriter, ucCreate create.Usecase, ucDelete remove.Usecase) *Consumer {
+	if c.RetryAttempts < 1 {
+		c.RetryAttempts = retryAttempts
+	}
+	b := backoff.Exponential{Min: c.RetryMinBackoff, Max: c.RetryMaxBackoff}.
+		WithDefaults(backoff.Exponential{Min: retryMinBackoff, Max: retryMaxBackoff})
+
+	return &Consumer{
+		attempts: c.RetryAttempts, backoff: b, kafka: k, deadLetter: deadLetter, ucCreate: ucCreate, ucDelete: ucDelete,
+	}
 }
 
 func (c *Consumer) Consume(ctx context.Context) error {
 	log.Info().Msg("Kafka consumer started")
+
+	var fetchErrors int
 	for {
 		select {
 		case <-ctx.Done():
@@ -28,23 +79,20 @@ func (c *Consumer) Consume(ctx context.Context) error {
 		default:
 			m, err := c.kafka.FetchMessage(ctx)
 			if err != nil {
-				log.Error().Err(err).Msg("c.kafka.FetchMessage")
-				continue
-			}
-
-			var input dto.CreateLinkInput
-			err = json.Unmarshal(m.Value, &input)
-			if err != nil {
-				log.Error().Err(err).Msg("json.Unmarshal")
+				if ctx.Err() != nil {
+					return nil
+				}
+				fetchErrors++
+				log.Error().Err(err).Int("attempt", fetchErrors).Msg("c.kafka.FetchMessage")
+				sleep(ctx, c.backoff.Delay(fetchErrors))
 				continue
 			}
+			fetchErrors = 0
 
-			output, err := c.uc.Create(ctx, input)
-			if err != nil {
-				log.Error().Err(err).Msg("uc.CreateLink")
+			if !c.process(ctx, m) {
+				// canceled, the message is fetched again by the next consumer
 				continue
 			}
-			log.Info().Msg("Link created: " + output.Str())
 
 			if err = c.kafka.CommitMessages(ctx, m); err != nil {
 				log.Error().Err(err).Msg("c.kafka.CommitMessages")
@@ -53,3 +101,139 @@ func (c *Consumer) Consume(ctx context.Context) error {
 		}
 	}
 }
+
+// process handles the message and reports whether it should be committed. Transient errors are retried
+// with backoff, the messages failing with a permanent error or out of attempts are sent to the dead-letter topic.
+func (c *Consumer) process(ctx context.Context, m kafka.Message) bool {
+	for attempt := 1; ; attempt++ {
+		err := c.handle(ctx, m)
+		switch {
+		case err == nil:
+			return true
+		case permanent(err):
+			log.Error().Err(err).Msg("Message rejected")
+			return c.sendDeadLetter(ctx, m, err, attempt, reasonRejected)
+		case attempt >= c.attempts:
+			log.Error().Err(err).Int("attempts", attempt).Msg("Message retries exhausted")
+			return c.sendDeadLetter(ctx, m, err, attempt, reasonExhausted)
+		}
+
+		log.Warn().Err(err).Int("attempt", attempt).Msg("Message failed, retrying")
+		retriesTotal.Inc()
+		if !sleep(ctx, c.backoff.Delay(attempt)) {
+			return false
+		}
+	}
+}
+
+// sendDeadLetter writes the message to the dead-letter topic and reports whether it was written.
+// Writing is retried until it succeeds or ctx is canceled, so the message is never lost.
+func (c *Consumer) sendDeadLetter(ctx context.Context, m kafka.Message, cause error, attempts int, reason string) bool {
+	msg := deadLetterMessage(m, cause, attempts)
+	for attempt := 1; ; attempt++ {
+		err := c.deadLetter.WriteMessages(ctx, msg)
+		if err == nil {
+			deadLettersTotal.WithLabelValues(reason).Inc()
+			return true
+		}
+
+		log.Error().Err(err).Int("attempt", attempt).Msg("c.deadLetter.WriteMessages")
+		if !sleep(ctx, c.backoff.Delay(attempt)) {
+			return false
+		}
+	}
+}
+
+// handle runs the command of the message.
+func (c *Consumer) handle(ctx context.Context, m kafka.Message) error {
+	switch command := header(m, HeaderCommand); command {
+	case "", CommandCreate:
+		return c.createLink(ctx, m.Value)
+	case CommandDelete:
+		return c.deleteLink(ctx, m.Value)
+	default:
+		return fmt.Errorf("%w: unknown command %q", errMalformed, command)
+	}
+}
+
+func (c *Consumer) createLink(ctx context.Context, value []byte) error {
+	var input dto.CreateLinkInput
+	if err := json.Unmarshal(value, &input); err != nil {
+		return fmt.Errorf("%w: json.Unmarshal: %w", errMalformed, err)
+	}
+
+	if err := input.Validate(); err != nil {
+		return fmt.Errorf("%w: input.Validate: %w", errMalformed, err)
+	}
+
+	output, err := c.ucCreate.Create(ctx, input)
+	switch {
+	case errors.Is(err, entity.ErrAliasTaken) && input.Alias != "":
+		return fmt.Errorf("%w: uc.CreateLink: %w", errConflict, err)
+	case errors.Is(err, entity.ErrAliasTaken):
+		// every generated alias collided, a retry generates new ones
+		return fmt.Errorf("uc.CreateLink: %w", err)
+	case errors.Is(err, entity.ErrAlreadyExist):
+		log.Info().Msg("Link already exists: " + output.Str())
+	case err != nil:
+		return fmt.Errorf("uc.CreateLink: %w", err)
+	default:
+		log.Info().Msg("Link created: " + output.Str())
+	}
+
+	return nil
+}
+
+func (c *Consumer) deleteLink(ctx context.Context, value []byte) error {
+	var input dto.DeleteLinkInput
+	if err := json.Unmarshal(value, &input); err != nil {
+		return fmt.Errorf("%w: json.Unmarshal: %w", errMalformed, err)
+	}
+
+	if err := input.Validate(); err != nil {
+		return fmt.Errorf("%w: input.Validate: %w", errMalformed, err)
+	}
+
+	err := c.ucDelete.Delete(ctx, input)
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		log.Info().Msg("Link not found: " + input.Alias)
+	case err != nil:
+		return fmt.Errorf("uc.DeleteLink: %w", err)
+	default:
+		log.Info().Msg("Link deleted: " + input.Alias)
+	}
+
+	return nil
+}
+
+// permanent reports whether the error repeats on every attempt to handle the message.
+func permanent(err error) bool {
+	return errors.Is(err, errMalformed) ||
+		errors.Is(err, errConflict) ||
+		errors.Is(err, entity.ErrInputValidation) ||
+		errors.Is(err, entity.ErrEntityValidation) ||
+		errors.Is(err, entity.ErrBlocked)
+}
+
+// sleep waits for the delay and reports whether ctx is still active.
+func sleep(ctx context.Context, delay time.Duration) bool {
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
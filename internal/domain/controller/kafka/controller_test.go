@@ -2,53 +2,89 @@ package kafka_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	adapterAlias "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/alias"
+	adapterBlocklist "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/blocklist"
+	adapterShortURL "github.com/xgmsx/go-url-shortener-ddd/internal/domain/adapter/shorturl"
 	controllerKafka "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/kafka"
 	mocksReader "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/kafka/mocks"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	ucCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create"
 	mocksCreate "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/create/mocks"
+	ucDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove"
+	mocksDelete "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/remove/mocks"
 )
 
+var shortURL, _ = adapterShortURL.New(adapterShortURL.Config{BaseURL: "https://sho.rt"})
+
+var blocklist, _ = adapterBlocklist.New(adapterBlocklist.Config{})
+
 func TestKafkaController(t *testing.T) {
-	initMock := func() (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache, *mocksCreate.Mockpublisher) {
+	initMock := func() (*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache) {
 		ctrl := gomock.NewController(t)
-		publisher := mocksCreate.NewMockpublisher(ctrl)
 		database := mocksCreate.NewMockdatabase(ctrl)
 		cache := mocksCreate.NewMockcache(ctrl)
-		return ctrl, database, cache, publisher
+		return ctrl, database, cache
 	}
 
 	testCases := []struct {
-		name      string
-		input     string
-		setupMock func(*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache, *mocksCreate.Mockpublisher)
+		name           string
+		config         ucCreate.Config
+		input          string
+		wantDeadLetter bool
+		setupMock      func(*gomock.Controller, *mocksCreate.Mockdatabase, *mocksCreate.Mockcache)
 	}{
 		{
 			name:  "Happy path",
 			input: `{"url": "https://example.com"}`,
-			setupMock: func(ctrl *gomock.Controller, database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			setupMock: func(ctrl *gomock.Controller, database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 				cache.EXPECT().PutLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-				publisher.EXPECT().SendLink(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
 		{
 			name:  "Link already exists",
 			input: `{"url": "https://example.com"}`,
-			setupMock: func(ctrl *gomock.Controller, database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache, publisher *mocksCreate.Mockpublisher) {
+			setupMock: func(ctrl *gomock.Controller, database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
 				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAlreadyExist).Times(1)
 			},
 		},
 		{
-			name:  "Validation error",
-			input: `https://example.com`,
+			name:   "Dedup: already shortened URL",
+			config: ucCreate.Config{Dedup: true},
+			input:  `{"url": "https://example.com"}`,
+			setupMock: func(ctrl *gomock.Controller, database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				existing := entity.Link{URL: "https://example.com", Alias: "existing"}
+				database.EXPECT().FindOrCreateLink(gomock.Any(), gomock.Any()).Return(existing, entity.ErrAlreadyExist).Times(1)
+			},
+		},
+		{
+			name:  "URL is normalized",
+			input: `{"url": "HTTPS://Example.COM:443/path"}`,
+			setupMock: func(ctrl *gomock.Controller, database *mocksCreate.Mockdatabase, cache *mocksCreate.Mockcache) {
+				link := gomock.Cond(func(l entity.Link) bool { return l.URL == "https://example.com/path" })
+				database.EXPECT().CreateLink(gomock.Any(), link).Return(nil).Times(1)
+				cache.EXPECT().PutLink(gomock.Any(), link).Return(nil).Times(1)
+			},
+		},
+		{
+			name:           "Validation error",
+			input:          `https://example.com`,
+			wantDeadLetter: true,
+		},
+		{
+			name:           "Validation error: private address",
+			input:          `{"url": "http://192.168.0.1/admin"}`,
+			wantDeadLetter: true,
 		},
 	}
 
@@ -57,11 +93,11 @@ func TestKafkaController(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			ctrl, database, cache, publisher := initMock()
+			ctrl, database, cache := initMock()
 			defer ctrl.Finish()
 
 			if tc.setupMock != nil {
-				tc.setupMock(ctrl, database, cache, publisher)
+				tc.setupMock(ctrl, database, cache)
 			}
 
 			// arrange
@@ -69,13 +105,290 @@ func TestKafkaController(t *testing.T) {
 			doFunc := func(ctx context.Context) (kafka.Message, error) { cancel(); return msg, nil }
 			reader := mocksReader.NewMockkafkaReader(ctrl)
 			reader.EXPECT().FetchMessage(gomock.Any()).DoAndReturn(doFunc).Times(1)
-			reader.EXPECT().CommitMessages(ctx, msg).Return(nil).MaxTimes(1)
+			reader.EXPECT().CommitMessages(ctx, msg).Return(nil).Times(1)
+			deadLetter := mocksReader.NewMockkafkaWriter(ctrl)
+			if tc.wantDeadLetter {
+				deadLetter.EXPECT().WriteMessages(ctx, gomock.Any()).Return(nil).Times(1)
+			}
 
 			// act
-			controller := controllerKafka.New(reader, ucCreate.New(database, cache, publisher))
+			uc := ucCreate.New(tc.config, database, cache, adapterAlias.NewUUID(), shortURL, blocklist)
+			controller := controllerKafka.New(controllerKafka.Config{}, reader, deadLetter, uc, ucDelete.Usecase{})
 			go func() { err := controller.Consume(ctx); assert.NoError(t, err) }()
 
 			<-time.After(time.Millisecond * 50)
 		})
 	}
 }
+
+func TestKafkaControllerDelete(t *testing.T) {
+	initMock := func() (*gomock.Controller, *mocksDelete.Mockdatabase, *mocksDelete.Mockcache) {
+		ctrl := gomock.NewController(t)
+		database := mocksDelete.NewMockdatabase(ctrl)
+		cache := mocksDelete.NewMockcache(ctrl)
+		return ctrl, database, cache
+	}
+
+	testCases := []struct {
+		name           string
+		input          string
+		wantDeadLetter bool
+		setupMock      func(*mocksDelete.Mockdatabase, *mocksDelete.Mockcache)
+	}{
+		{
+			name:  "Happy path",
+			input: `{"alias": "alias1"}`,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				link := entity.Link{URL: "https://example.com", Alias: "alias1"}
+				database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).Return(link, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:  "Link not found",
+			input: `{"alias": "unknown"}`,
+			setupMock: func(database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache) {
+				database.EXPECT().DeleteLink(gomock.Any(), "unknown", uuid.Nil).Return(entity.Link{}, entity.ErrNotFound).Times(1)
+			},
+		},
+		{
+			name:           "Validation error",
+			input:          `{"alias": "a"}`,
+			wantDeadLetter: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ctrl, database, cache := initMock()
+			defer ctrl.Finish()
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			msg := kafka.Message{
+				Value:   []byte(tc.input),
+				Headers: []kafka.Header{{Key: controllerKafka.HeaderCommand, Value: []byte(controllerKafka.CommandDelete)}},
+			}
+			doFunc := func(ctx context.Context) (kafka.Message, error) { cancel(); return msg, nil }
+			reader := mocksReader.NewMockkafkaReader(ctrl)
+			reader.EXPECT().FetchMessage(gomock.Any()).DoAndReturn(doFunc).Times(1)
+			reader.EXPECT().CommitMessages(ctx, msg).Return(nil).Times(1)
+			deadLetter := mocksReader.NewMockkafkaWriter(ctrl)
+			if tc.wantDeadLetter {
+				deadLetter.EXPECT().WriteMessages(ctx, gomock.Any()).Return(nil).Times(1)
+			}
+
+			// act
+			controller := controllerKafka.New(controllerKafka.Config{}, reader, deadLetter, ucCreate.Usecase{}, ucDelete.New(database, cache))
+			err := controller.Consume(ctx)
+
+			// assert
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestKafkaControllerRetry(t *testing.T) {
+	errDB := errors.New("test db error")
+	errKafka := errors.New("test kafka error")
+	link := entity.Link{URL: "https://example.com", Alias: "alias1"}
+
+	deadLetterOf := func(input, errText, attempts string) gomock.Matcher {
+		return gomock.Cond(func(m kafka.Message) bool {
+			return string(m.Key) == "alias1" && string(m.Value) == input && m.Topic == "" &&
+				header(m, controllerKafka.HeaderCommand) == controllerKafka.CommandDelete &&
+				strings.Contains(header(m, controllerKafka.HeaderDLQError), errText) &&
+				header(m, controllerKafka.HeaderDLQAttempts) == attempts &&
+				header(m, controllerKafka.HeaderDLQTopic) == "links-requested" &&
+				header(m, controllerKafka.HeaderDLQPartition) == "2" &&
+				header(m, controllerKafka.HeaderDLQOffset) == "42"
+		})
+	}
+
+	testCases := []struct {
+		name       string
+		input      string
+		fetchErr   error
+		wantCommit bool
+		setupMock  func(context.CancelFunc, *mocksDelete.Mockdatabase, *mocksDelete.Mockcache, *mocksReader.MockkafkaWriter)
+	}{
+		{
+			name:       "Transient error is retried",
+			input:      `{"alias": "alias1"}`,
+			wantCommit: true,
+			setupMock: func(_ context.CancelFunc, database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache, _ *mocksReader.MockkafkaWriter) {
+				gomock.InOrder(
+					database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).Return(entity.Link{}, errDB).Times(2),
+					database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).Return(link, nil).Times(1),
+				)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Retries exhausted",
+			input:      `{"alias": "alias1"}`,
+			wantCommit: true,
+			setupMock: func(_ context.CancelFunc, database *mocksDelete.Mockdatabase, _ *mocksDelete.Mockcache, deadLetter *mocksReader.MockkafkaWriter) {
+				database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).Return(entity.Link{}, errDB).Times(3)
+				deadLetter.EXPECT().WriteMessages(gomock.Any(), deadLetterOf(`{"alias": "alias1"}`, "test db error", "3")).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Poison message is not retried",
+			input:      `{"alias": 1}`,
+			wantCommit: true,
+			setupMock: func(_ context.CancelFunc, _ *mocksDelete.Mockdatabase, _ *mocksDelete.Mockcache, deadLetter *mocksReader.MockkafkaWriter) {
+				deadLetter.EXPECT().WriteMessages(gomock.Any(), deadLetterOf(`{"alias": 1}`, "malformed message", "1")).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Dead-letter write is retried",
+			input:      `{"alias": "a"}`,
+			wantCommit: true,
+			setupMock: func(_ context.CancelFunc, _ *mocksDelete.Mockdatabase, _ *mocksDelete.Mockcache, deadLetter *mocksReader.MockkafkaWriter) {
+				gomock.InOrder(
+					deadLetter.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(errKafka).Times(1),
+					deadLetter.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+				)
+			},
+		},
+		{
+			name:  "Canceled while retrying",
+			input: `{"alias": "alias1"}`,
+			setupMock: func(cancel context.CancelFunc, database *mocksDelete.Mockdatabase, _ *mocksDelete.Mockcache, _ *mocksReader.MockkafkaWriter) {
+				doFunc := func(context.Context, string, uuid.UUID) (entity.Link, error) { cancel(); return entity.Link{}, errDB }
+				database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).DoAndReturn(doFunc).Times(1)
+			},
+		},
+		{
+			name:       "Fetch error is retried",
+			input:      `{"alias": "alias1"}`,
+			fetchErr:   errKafka,
+			wantCommit: true,
+			setupMock: func(_ context.CancelFunc, database *mocksDelete.Mockdatabase, cache *mocksDelete.Mockcache, _ *mocksReader.MockkafkaWriter) {
+				database.EXPECT().DeleteLink(gomock.Any(), "alias1", uuid.Nil).Return(link, nil).Times(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksDelete.NewMockdatabase(ctrl)
+			cache := mocksDelete.NewMockcache(ctrl)
+			deadLetter := mocksReader.NewMockkafkaWriter(ctrl)
+			tc.setupMock(cancel, database, cache, deadLetter)
+
+			// arrange
+			msg := kafka.Message{
+				Topic:     "links-requested",
+				Partition: 2,
+				Offset:    42,
+				Key:       []byte("alias1"),
+				Value:     []byte(tc.input),
+				Headers:   []kafka.Header{{Key: controllerKafka.HeaderCommand, Value: []byte(controllerKafka.CommandDelete)}},
+			}
+			reader := mocksReader.NewMockkafkaReader(ctrl)
+			if tc.fetchErr != nil {
+				reader.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, tc.fetchErr).Times(1)
+			}
+			reader.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil).Times(1)
+			if tc.wantCommit {
+				doFunc := func(context.Context, ...kafka.Message) error { cancel(); return nil }
+				reader.EXPECT().CommitMessages(gomock.Any(), msg).DoAndReturn(doFunc).Times(1)
+			}
+
+			// act
+			config := controllerKafka.Config{RetryAttempts: 3, RetryMinBackoff: time.Millisecond}
+			controller := controllerKafka.New(config, reader, deadLetter, ucCreate.Usecase{}, ucDelete.New(database, cache))
+			err := controller.Consume(ctx)
+
+			// assert
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestKafkaControllerAliasTaken(t *testing.T) {
+	deadLetterOf := func(errText, attempts string) gomock.Matcher {
+		return gomock.Cond(func(m kafka.Message) bool {
+			return strings.Contains(header(m, controllerKafka.HeaderDLQError), errText) &&
+				header(m, controllerKafka.HeaderDLQAttempts) == attempts
+		})
+	}
+
+	testCases := []struct {
+		name      string
+		input     string
+		setupMock func(*mocksCreate.Mockdatabase, *mocksReader.MockkafkaWriter)
+	}{
+		{
+			name:  "Custom alias taken is not retried",
+			input: `{"url": "https://example.com", "alias": "custom"}`,
+			setupMock: func(database *mocksCreate.Mockdatabase, deadLetter *mocksReader.MockkafkaWriter) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAliasTaken).Times(1)
+				deadLetter.EXPECT().WriteMessages(gomock.Any(), deadLetterOf("conflicting message", "1")).Return(nil).Times(1)
+			},
+		},
+		{
+			name:  "Generated alias taken is retried",
+			input: `{"url": "https://example.com"}`,
+			setupMock: func(database *mocksCreate.Mockdatabase, deadLetter *mocksReader.MockkafkaWriter) {
+				database.EXPECT().CreateLink(gomock.Any(), gomock.Any()).Return(entity.ErrAliasTaken).Times(3)
+				deadLetter.EXPECT().WriteMessages(gomock.Any(), deadLetterOf("alias taken", "3")).Return(nil).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksCreate.NewMockdatabase(ctrl)
+			cache := mocksCreate.NewMockcache(ctrl)
+			deadLetter := mocksReader.NewMockkafkaWriter(ctrl)
+			tc.setupMock(database, deadLetter)
+
+			// arrange
+			msg := kafka.Message{Value: []byte(tc.input)}
+			reader := mocksReader.NewMockkafkaReader(ctrl)
+			reader.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil).Times(1)
+			doFunc := func(context.Context, ...kafka.Message) error { cancel(); return nil }
+			reader.EXPECT().CommitMessages(gomock.Any(), msg).DoAndReturn(doFunc).Times(1)
+
+			// act
+			uc := ucCreate.New(ucCreate.Config{AliasAttempts: 1}, database, cache, adapterAlias.NewUUID(), shortURL, blocklist)
+			config := controllerKafka.Config{RetryAttempts: 3, RetryMinBackoff: time.Millisecond}
+			controller := controllerKafka.New(config, reader, deadLetter, uc, ucDelete.Usecase{})
+			err := controller.Consume(ctx)
+
+			// assert
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func header(m kafka.Message, key string) string {
+	for _, h := range m.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package kafka
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Headers added to the messages of the dead-letter topic along with the original headers.
+const (
+	HeaderDLQError    = "dlq_error"    // error of the last attempt
+	HeaderDLQAttempts = "dlq_attempts" // attempts to handle the message
+	HeaderDLQTime     = "dlq_time"     // RFC 3339 time the message was dead-lettered
+	// position of the message in the original topic
+	HeaderDLQTopic     = "dlq_topic"
+	HeaderDLQPartition = "dlq_partition"
+	HeaderDLQOffset    = "dlq_offset"
+)
+
+const headerDLQPrefix = "dlq_"
+
+const (
+	reasonRejected  = "rejected"  // permanent error
+	reasonExhausted = "exhausted" // transient error, out of attempts
+)
+
+// deadLetterMessage keeps the key, value and headers of the message and describes the failure in the headers.
+func deadLetterMessage(m kafka.Message, cause error, attempts int) kafka.Message {
+	headers := make([]kafka.Header, 0, len(m.Headers)+6)
+	headers = append(headers, m.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: HeaderDLQError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderDLQAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderDLQTime, Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+		kafka.Header{Key: HeaderDLQTopic, Value: []byte(m.Topic)},
+		kafka.Header{Key: HeaderDLQPartition, Value: []byte(strconv.Itoa(m.Partition))},
+		kafka.Header{Key: HeaderDLQOffset, Value: []byte(strconv.FormatInt(m.Offset, 10))},
+	)
+
+	return kafka.Message{Key: m.Key, Value: m.Value, Headers: headers}
+}
+
+// ReplayMessage restores the original message from the message of the dead-letter topic.
+func ReplayMessage(m kafka.Message) kafka.Message {
+	headers := make([]kafka.Header, 0, len(m.Headers))
+	for _, h := range m.Headers {
+		if !strings.HasPrefix(h.Key, headerDLQPrefix) {
+			headers = append(headers, h)
+		}
+	}
+
+	return kafka.Message{Key: m.Key, Value: m.Value, Headers: headers}
+}
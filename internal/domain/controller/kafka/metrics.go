@@ -0,0 +1,18 @@
+package kafka
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	retriesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "kafka_consumer_retries_total",
+		Help: "Count all retries of the consumed messages failed with a transient error.",
+	})
+
+	deadLettersTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_consumer_dead_letters_total",
+		Help: "Count all consumed messages sent to the dead-letter topic by reason.",
+	}, []string{"reason"})
+)
@@ -74,3 +74,46 @@ func (mr *MockkafkaReaderMockRecorder) FetchMessage(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMessage", reflect.TypeOf((*MockkafkaReader)(nil).FetchMessage), ctx)
 }
+
+// MockkafkaWriter is a mock of kafkaWriter interface.
+type MockkafkaWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockkafkaWriterMockRecorder
+	isgomock struct{}
+}
+
+// MockkafkaWriterMockRecorder is the mock recorder for MockkafkaWriter.
+type MockkafkaWriterMockRecorder struct {
+	mock *MockkafkaWriter
+}
+
+// NewMockkafkaWriter creates a new mock instance.
+func NewMockkafkaWriter(ctrl *gomock.Controller) *MockkafkaWriter {
+	mock := &MockkafkaWriter{ctrl: ctrl}
+	mock.recorder = &MockkafkaWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkafkaWriter) EXPECT() *MockkafkaWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteMessages mocks base method.
+func (m *MockkafkaWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range msgs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WriteMessages", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteMessages indicates an expected call of WriteMessages.
+func (mr *MockkafkaWriterMockRecorder) WriteMessages(ctx any, msgs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, msgs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteMessages", reflect.TypeOf((*MockkafkaWriter)(nil).WriteMessages), varargs...)
+}
@@ -0,0 +1,83 @@
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/reap"
+)
+
+type Config struct {
+	Enabled   bool          `env:"REAPER_ENABLED, default=true"`
+	Interval  time.Duration `env:"REAPER_INTERVAL, default=1m"`
+	BatchSize int           `env:"REAPER_BATCH_SIZE, default=1000"`
+}
+
+// Reaper periodically deletes expired links.
+type Reaper struct {
+	config Config
+	uc     reap.Usecase
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(c Config, uc reap.Usecase) *Reaper {
+	return &Reaper{config: c, uc: uc, cancel: func() {}, done: make(chan struct{})}
+}
+
+// Start runs the reaper in background until ctx is canceled or Close is called.
+func (r *Reaper) Start(ctx context.Context) {
+	if !r.config.Enabled || r.config.Interval <= 0 || r.config.BatchSize < 1 {
+		log.Info().Msg("Reaper disabled")
+		close(r.done)
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	go r.run(ctx)
+}
+
+func (r *Reaper) Close() {
+	r.cancel()
+	<-r.done
+	log.Info().Msg("Reaper closed")
+}
+
+func (r *Reaper) run(ctx context.Context) {
+	defer close(r.done)
+
+	log.Info().Msg("Reaper started")
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reap(ctx)
+		}
+	}
+}
+
+func (r *Reaper) reap(ctx context.Context) {
+	start := time.Now()
+	defer func() { runDuration.Observe(time.Since(start).Seconds()) }()
+
+	output, err := r.uc.Reap(ctx, dto.ReapLinksInput{Before: start, BatchSize: r.config.BatchSize})
+	linksReaped.Add(float64(output.Reaped))
+	if err != nil {
+		runsTotal.WithLabelValues("error").Inc()
+		log.Error().Err(err).Msg("uc.Reap")
+		return
+	}
+
+	runsTotal.WithLabelValues("success").Inc()
+	if output.Reaped > 0 {
+		log.Info().Int("reaped", output.Reaped).Msg("Expired links reaped")
+	}
+}
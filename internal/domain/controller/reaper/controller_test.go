@@ -0,0 +1,76 @@
+package reaper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	controllerReaper "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/reaper"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	ucReap "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/reap"
+	mocksReap "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/reap/mocks"
+)
+
+func TestReaper(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    controllerReaper.Config
+		setupMock func(database *mocksReap.Mockdatabase, cache *mocksReap.Mockcache)
+	}{
+		{
+			name:   "Happy path",
+			config: controllerReaper.Config{Enabled: true, Interval: 10 * time.Millisecond, BatchSize: 2},
+			setupMock: func(database *mocksReap.Mockdatabase, cache *mocksReap.Mockcache) {
+				links := []entity.Link{{Alias: "alias1"}, {Alias: "alias2"}}
+				first := database.EXPECT().DeleteExpiredLinks(gomock.Any(), gomock.Any(), 2).Return(links, nil).Times(1)
+				database.EXPECT().DeleteExpiredLinks(gomock.Any(), gomock.Any(), 2).Return(nil, nil).After(first).MinTimes(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1", "alias2").Return(nil).Times(1)
+			},
+		},
+		{
+			name:   "Database error",
+			config: controllerReaper.Config{Enabled: true, Interval: 10 * time.Millisecond, BatchSize: 2},
+			setupMock: func(database *mocksReap.Mockdatabase, cache *mocksReap.Mockcache) {
+				database.EXPECT().DeleteExpiredLinks(gomock.Any(), gomock.Any(), 2).Return(nil, errors.New("test db error")).MinTimes(1)
+			},
+		},
+		{
+			name:   "Cache error",
+			config: controllerReaper.Config{Enabled: true, Interval: 10 * time.Millisecond, BatchSize: 10},
+			setupMock: func(database *mocksReap.Mockdatabase, cache *mocksReap.Mockcache) {
+				links := []entity.Link{{Alias: "alias1"}}
+				database.EXPECT().DeleteExpiredLinks(gomock.Any(), gomock.Any(), 10).Return(links, nil).MinTimes(1)
+				cache.EXPECT().DeleteLinks(gomock.Any(), "alias1").Return(errors.New("test cache error")).MinTimes(1)
+			},
+		},
+		{
+			name:   "Disabled",
+			config: controllerReaper.Config{Enabled: false, Interval: 10 * time.Millisecond, BatchSize: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksReap.NewMockdatabase(ctrl)
+			cache := mocksReap.NewMockcache(ctrl)
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, cache)
+			}
+
+			// arrange
+			reaper := controllerReaper.New(tc.config, ucReap.New(database, cache))
+
+			// act
+			reaper.Start(context.Background())
+			<-time.After(time.Millisecond * 50)
+			reaper.Close()
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package reaper
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	linksReaped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reaper_links_reaped_total",
+		Help: "Count all expired links deleted by the reaper.",
+	})
+
+	runsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "reaper_runs_total",
+		Help: "Count all reaper runs by status.",
+	}, []string{"status"})
+
+	runDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "reaper_run_duration_seconds",
+		Help:    "Duration of reaper runs.",
+		Buckets: prometheus.DefBuckets,
+	})
+)
@@ -0,0 +1,82 @@
+package relay
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/relay"
+)
+
+type Config struct {
+	Enabled   bool          `env:"OUTBOX_RELAY_ENABLED, default=true"`
+	Interval  time.Duration `env:"OUTBOX_RELAY_INTERVAL, default=1s"`
+	BatchSize int           `env:"OUTBOX_RELAY_BATCH_SIZE, default=500"`
+}
+
+// Relay periodically publishes the link events of the outbox to kafka.
+type Relay struct {
+	config Config
+	uc     relay.Usecase
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(c Config, uc relay.Usecase) *Relay {
+	return &Relay{config: c, uc: uc, cancel: func() {}, done: make(chan struct{})}
+}
+
+// Start runs the relay in background until ctx is canceled or Close is called.
+func (r *Relay) Start(ctx context.Context) {
+	if !r.config.Enabled || r.config.Interval <= 0 || r.config.BatchSize < 1 {
+		log.Info().Msg("Outbox relay disabled")
+		close(r.done)
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	go r.run(ctx)
+}
+
+func (r *Relay) Close() {
+	r.cancel()
+	<-r.done
+	log.Info().Msg("Outbox relay closed")
+}
+
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+
+	log.Info().Msg("Outbox relay started")
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.relay(ctx)
+		}
+	}
+}
+
+func (r *Relay) relay(ctx context.Context) {
+	start := time.Now()
+	defer func() { runDuration.Observe(time.Since(start).Seconds()) }()
+
+	output, err := r.uc.Relay(ctx, dto.RelayEventsInput{BatchSize: r.config.BatchSize})
+	eventsPublished.Add(float64(output.Published))
+	eventsPending.Set(float64(output.Pending))
+	lagSeconds.Set(output.Lag.Seconds())
+	if err != nil {
+		runsTotal.WithLabelValues("error").Inc()
+		log.Error().Err(err).Int("pending", output.Pending).Dur("lag", output.Lag).Msg("uc.Relay")
+		return
+	}
+
+	runsTotal.WithLabelValues("success").Inc()
+}
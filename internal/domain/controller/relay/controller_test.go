@@ -0,0 +1,106 @@
+package relay_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	controllerRelay "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/relay"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	ucRelay "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/relay"
+	mocksRelay "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/relay/mocks"
+)
+
+type relayFunc = func(context.Context, int, func(context.Context, []entity.LinkEvent) error, func(int) time.Duration) (int, error)
+
+// relayEvents passes the due events to the publish callback like the database does.
+func relayEvents(events ...entity.LinkEvent) relayFunc {
+	return func(ctx context.Context, _ int, publish func(context.Context, []entity.LinkEvent) error, _ func(int) time.Duration) (int, error) {
+		if len(events) == 0 {
+			return 0, nil
+		}
+		if err := publish(ctx, events); err != nil {
+			return 0, err
+		}
+		return len(events), nil
+	}
+}
+
+func TestRelay(t *testing.T) {
+	events := []entity.LinkEvent{
+		{ID: 1, Event: entity.LinkEventCreated, Alias: "alias1", URL: "https://example.com"},
+		{ID: 2, Event: entity.LinkEventDeleted, Alias: "alias2", URL: "https://example.org"},
+	}
+
+	testCases := []struct {
+		name      string
+		config    controllerRelay.Config
+		setupMock func(database *mocksRelay.Mockdatabase, publisher *mocksRelay.Mockpublisher)
+	}{
+		{
+			name:   "Happy path",
+			config: controllerRelay.Config{Enabled: true, Interval: 10 * time.Millisecond, BatchSize: 2},
+			setupMock: func(database *mocksRelay.Mockdatabase, publisher *mocksRelay.Mockpublisher) {
+				first := database.EXPECT().RelayLinkEvents(gomock.Any(), 2, gomock.Any(), gomock.Any()).DoAndReturn(relayEvents(events...)).Times(1)
+				database.EXPECT().RelayLinkEvents(gomock.Any(), 2, gomock.Any(), gomock.Any()).DoAndReturn(relayEvents()).After(first).MinTimes(1)
+				publisher.EXPECT().SendLinkEvents(gomock.Any(), events[0], events[1]).Return(nil).Times(1)
+				database.EXPECT().LinkEventsStats(gomock.Any()).Return(entity.OutboxStats{}, nil).MinTimes(1)
+			},
+		},
+		{
+			name:   "Publisher error",
+			config: controllerRelay.Config{Enabled: true, Interval: 10 * time.Millisecond, BatchSize: 10},
+			setupMock: func(database *mocksRelay.Mockdatabase, publisher *mocksRelay.Mockpublisher) {
+				database.EXPECT().RelayLinkEvents(gomock.Any(), 10, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, limit int, publish func(context.Context, []entity.LinkEvent) error, backoff func(int) time.Duration) (int, error) {
+						// failed events are retried with exponential backoff
+						assert.Equal(t, time.Second, backoff(1))
+						assert.Equal(t, 4*time.Second, backoff(3))
+						assert.Equal(t, 5*time.Minute, backoff(100))
+						return relayEvents(events...)(ctx, limit, publish, backoff)
+					}).MinTimes(1)
+				publisher.EXPECT().SendLinkEvents(gomock.Any(), events[0], events[1]).Return(errors.New("test kafka error")).MinTimes(1)
+				stats := entity.OutboxStats{Pending: 2, OldestAt: time.Now().Add(-time.Minute)}
+				database.EXPECT().LinkEventsStats(gomock.Any()).Return(stats, nil).MinTimes(1)
+			},
+		},
+		{
+			name:   "Database error",
+			config: controllerRelay.Config{Enabled: true, Interval: 10 * time.Millisecond, BatchSize: 10},
+			setupMock: func(database *mocksRelay.Mockdatabase, publisher *mocksRelay.Mockpublisher) {
+				database.EXPECT().RelayLinkEvents(gomock.Any(), 10, gomock.Any(), gomock.Any()).Return(0, errors.New("test db error")).MinTimes(1)
+				database.EXPECT().LinkEventsStats(gomock.Any()).Return(entity.OutboxStats{}, errors.New("test db error")).MinTimes(1)
+			},
+		},
+		{
+			name:   "Disabled",
+			config: controllerRelay.Config{Enabled: false, Interval: 10 * time.Millisecond, BatchSize: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksRelay.NewMockdatabase(ctrl)
+			publisher := mocksRelay.NewMockpublisher(ctrl)
+
+			if tc.setupMock != nil {
+				tc.setupMock(database, publisher)
+			}
+
+			// arrange
+			relay := controllerRelay.New(tc.config, ucRelay.New(ucRelay.Config{}, database, publisher))
+
+			// act
+			relay.Start(context.Background())
+			<-time.After(time.Millisecond * 50)
+			relay.Close()
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package relay
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	eventsPublished = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_events_published_total",
+		Help: "Count all link events published from the outbox.",
+	})
+
+	eventsPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_events_pending",
+		Help: "Number of link events waiting in the outbox.",
+	})
+
+	lagSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_lag_seconds",
+		Help: "Age of the oldest link event waiting in the outbox.",
+	})
+
+	runsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "outbox_relay_runs_total",
+		Help: "Count all outbox relay runs by status.",
+	}, []string{"status"})
+
+	runDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "outbox_relay_run_duration_seconds",
+		Help:    "Duration of outbox relay runs.",
+		Buckets: prometheus.DefBuckets,
+	})
+)
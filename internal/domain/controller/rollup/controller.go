@@ -0,0 +1,91 @@
+package rollup
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+)
+
+type Config struct {
+	Enabled   bool          `env:"CLICKS_ROLLUP_ENABLED, default=true"`
+	Interval  time.Duration `env:"CLICKS_ROLLUP_INTERVAL, default=5m"`
+	Delay     time.Duration `env:"CLICKS_ROLLUP_DELAY, default=5m"` // grace period for clicks recorded late
+	Retention time.Duration `env:"CLICKS_RETENTION, default=2160h"` // raw clicks lifetime, 0 keeps them forever
+	BatchSize int           `env:"CLICKS_RETENTION_BATCH_SIZE, default=10000"`
+}
+
+// Rollup periodically counts raw clicks into the hourly rollups
+// and deletes the raw clicks that are out of the retention period.
+type Rollup struct {
+	config Config
+	uc     stats.Usecase
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(c Config, uc stats.Usecase) *Rollup {
+	return &Rollup{config: c, uc: uc, cancel: func() {}, done: make(chan struct{})}
+}
+
+// Start runs the rollup in background until ctx is canceled or Close is called.
+func (r *Rollup) Start(ctx context.Context) {
+	if !r.config.Enabled || r.config.Interval <= 0 || r.config.BatchSize < 1 {
+		log.Info().Msg("Clicks rollup disabled")
+		close(r.done)
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	go r.run(ctx)
+}
+
+func (r *Rollup) Close() {
+	r.cancel()
+	<-r.done
+	log.Info().Msg("Clicks rollup closed")
+}
+
+func (r *Rollup) run(ctx context.Context) {
+	defer close(r.done)
+
+	log.Info().Msg("Clicks rollup started")
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.rollup(ctx)
+		}
+	}
+}
+
+func (r *Rollup) rollup(ctx context.Context) {
+	start := time.Now()
+	defer func() { runDuration.Observe(time.Since(start).Seconds()) }()
+
+	input := dto.RollupClicksInput{Before: start.Add(-r.config.Delay), BatchSize: r.config.BatchSize}
+	if r.config.Retention > 0 {
+		input.RetainAfter = start.Add(-r.config.Retention)
+	}
+
+	output, err := r.uc.Rollup(ctx, input)
+	clicksDeleted.Add(float64(output.Deleted))
+	if err != nil {
+		runsTotal.WithLabelValues("error").Inc()
+		log.Error().Err(err).Msg("uc.Rollup")
+		return
+	}
+
+	runsTotal.WithLabelValues("success").Inc()
+	if output.Deleted > 0 {
+		log.Info().Int64("deleted", output.Deleted).Msg("Raw clicks out of retention deleted")
+	}
+}
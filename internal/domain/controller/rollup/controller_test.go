@@ -0,0 +1,71 @@
+package rollup_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	controllerRollup "github.com/xgmsx/go-url-shortener-ddd/internal/domain/controller/rollup"
+	ucStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats"
+	mocksStats "github.com/xgmsx/go-url-shortener-ddd/internal/domain/usecase/stats/mocks"
+)
+
+func TestRollup(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    controllerRollup.Config
+		setupMock func(database *mocksStats.Mockdatabase)
+	}{
+		{
+			name:   "Happy path",
+			config: controllerRollup.Config{Enabled: true, Interval: 10 * time.Millisecond, Retention: time.Hour, BatchSize: 2},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().RollupClicks(gomock.Any(), gomock.Any()).Return(nil).MinTimes(1)
+				first := database.EXPECT().DeleteClicks(gomock.Any(), gomock.Any(), 2).Return(int64(2), nil).Times(1)
+				database.EXPECT().DeleteClicks(gomock.Any(), gomock.Any(), 2).Return(int64(0), nil).After(first).MinTimes(1)
+			},
+		},
+		{
+			name:   "Raw clicks kept forever",
+			config: controllerRollup.Config{Enabled: true, Interval: 10 * time.Millisecond, BatchSize: 2},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().RollupClicks(gomock.Any(), gomock.Any()).Return(nil).MinTimes(1)
+			},
+		},
+		{
+			name:   "Database error",
+			config: controllerRollup.Config{Enabled: true, Interval: 10 * time.Millisecond, Retention: time.Hour, BatchSize: 2},
+			setupMock: func(database *mocksStats.Mockdatabase) {
+				database.EXPECT().RollupClicks(gomock.Any(), gomock.Any()).Return(errors.New("test db error")).MinTimes(1)
+			},
+		},
+		{
+			name:   "Disabled",
+			config: controllerRollup.Config{Enabled: false, Interval: 10 * time.Millisecond, BatchSize: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			database := mocksStats.NewMockdatabase(ctrl)
+
+			if tc.setupMock != nil {
+				tc.setupMock(database)
+			}
+
+			// arrange
+			rollup := controllerRollup.New(tc.config, ucStats.New(database, nil, nil))
+
+			// act
+			rollup.Start(context.Background())
+			<-time.After(time.Millisecond * 50)
+			rollup.Close()
+		})
+	}
+}
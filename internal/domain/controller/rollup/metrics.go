@@ -0,0 +1,24 @@
+package rollup
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	clicksDeleted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "rollup_clicks_deleted_total",
+		Help: "Count all raw clicks deleted by the retention policy.",
+	})
+
+	runsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rollup_runs_total",
+		Help: "Count all click rollup runs by status.",
+	}, []string{"status"})
+
+	runDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "rollup_run_duration_seconds",
+		Help:    "Duration of click rollup runs.",
+		Buckets: prometheus.DefBuckets,
+	})
+)
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// CreateAPIKeyInput creates a key of a new owner, or of the existing one to rotate the keys.
+type CreateAPIKeyInput struct {
+	Name    string    `json:"name"`
+	OwnerID uuid.UUID `json:"owner_id,omitempty"`
+}
+
+func (i CreateAPIKeyInput) Validate() error {
+	if len(i.Name) > 64 {
+		return entity.NewValidationError("name", "must be at most 64 characters long")
+	}
+	return nil
+}
+
+type CreateAPIKeyOutput struct {
+	ID        uuid.UUID `json:"id"`
+	OwnerID   uuid.UUID `json:"owner_id"`
+	Name      string    `json:"name"`
+	Key       string    `json:"key"` // shown once, only the hash is stored
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (o CreateAPIKeyOutput) Load(k entity.APIKey, key string) CreateAPIKeyOutput {
+	o.ID = k.ID
+	o.OwnerID = k.OwnerID
+	o.Name = k.Name
+	o.Key = key
+	o.CreatedAt = k.CreatedAt
+	return o
+}
+
+type RevokeAPIKeyInput struct {
+	ID uuid.UUID `json:"id"`
+}
+
+func (i RevokeAPIKeyInput) Validate() error {
+	if i.ID == uuid.Nil {
+		return entity.NewValidationError("id", "must not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package dto
+
+type SyncBlockedLinksInput struct {
+	BatchSize int `json:"batch_size"`
+}
+
+type SyncBlockedLinksOutput struct {
+	Blocked   int `json:"blocked"`
+	Unblocked int `json:"unblocked"`
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -10,28 +11,104 @@ import (
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 )
 
+const (
+	minPasswordLength = 4
+	maxPasswordLength = 72 // bcrypt ignores the bytes after the 72nd byte
+)
+
+// MaxExpiresIn bounds expires_in (100 years) far below the overflow of time.Duration.
+const MaxExpiresIn = 100 * 365 * 24 * 60 * 60
+
+const aliasDescription = "must be 2-64 characters long and contain only letters, digits, '_' and '-'"
+
+var aliasPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{2,64}$`)
+
 type CreateLinkInput struct {
-	URL string `json:"url"`
+	URL          string     `json:"url"`
+	Alias        string     `json:"alias,omitempty"`
+	ExpiresIn    int64      `json:"expires_in,omitempty"` // seconds
+	ExpiredAt    *time.Time `json:"expired_at,omitempty"`
+	NeverExpires bool       `json:"never_expires,omitempty"`
+	RedirectCode int        `json:"redirect_code,omitempty"` // 301, 302, 307 or 308, LINK_REDIRECT_CODE if not set
+	ForwardQuery bool       `json:"forward_query,omitempty"` // merge the query string of the redirect request into the URL
+	Password     string     `json:"password,omitempty"`      // visitors must enter it before the redirect
+	MaxClicks    int        `json:"max_clicks,omitempty"`    // redirects before the link stops working, 1 - one-time link
+}
+
+// Expiration returns the requested expiration of the link.
+func (i *CreateLinkInput) Expiration() entity.Expiration {
+	return entity.Expiration{ExpiresIn: i.ExpiresIn, ExpiredAt: i.ExpiredAt, NeverExpires: i.NeverExpires}
 }
 
+// Validate checks the input and normalizes the URL.
 func (i *CreateLinkInput) Validate() error {
-	if i.URL == "" {
-		return entity.ErrInputValidation
+	url, err := entity.NormalizeURL(i.URL)
+	if err != nil {
+		return err
+	}
+	i.URL = url
+
+	if i.Alias != "" && !aliasPattern.MatchString(i.Alias) {
+		return entity.NewValidationError("alias", aliasDescription)
+	}
+
+	if entity.IsReservedAlias(i.Alias) {
+		return entity.NewValidationError("alias", "is reserved")
+	}
+
+	if i.MaxClicks < 0 {
+		return entity.NewValidationError("max_clicks", "must not be negative")
+	}
+
+	if i.Password != "" && (len(i.Password) < minPasswordLength || len(i.Password) > maxPasswordLength) {
+		return entity.NewValidationError("password", fmt.Sprintf("must be %d-%d bytes long", minPasswordLength, maxPasswordLength))
+	}
+
+	if i.RedirectCode != 0 && !entity.IsValidRedirectCode(i.RedirectCode) {
+		return entity.NewValidationError("redirect_code", "must be one of 301, 302, 307, 308")
+	}
+
+	if i.ExpiresIn < 0 {
+		return entity.NewValidationError("expires_in", "must not be negative")
+	}
+	if i.ExpiresIn > MaxExpiresIn {
+		return entity.NewValidationError("expires_in", fmt.Sprintf("must not exceed %d seconds", MaxExpiresIn))
+	}
+
+	expirations := 0
+	for _, isSet := range []bool{i.ExpiresIn > 0, i.ExpiredAt != nil, i.NeverExpires} {
+		if isSet {
+			expirations++
+		}
+	}
+	if expirations > 1 {
+		return entity.NewValidationError("expires_in", "only one of expires_in, expired_at and never_expires may be set")
 	}
 
 	return nil
 }
 
 type CreateLinkOutput struct {
-	URL       string    `json:"url"`
-	Alias     string    `json:"alias"`
-	ExpiredAt time.Time `json:"expired_at"`
+	URL          string     `json:"url"`
+	Alias        string     `json:"alias"`
+	ShortURL     string     `json:"short_url"`
+	ExpiredAt    *time.Time `json:"expired_at"`
+	RedirectCode int        `json:"redirect_code"`
+	ForwardQuery bool       `json:"forward_query"`
+	// PasswordProtected links open after the visitor enters the password
+	PasswordProtected bool `json:"password_protected"`
+	MaxClicks         int  `json:"max_clicks"` // 0 - unlimited
 }
 
-func (o CreateLinkOutput) Load(l entity.Link) CreateLinkOutput {
+func (o CreateLinkOutput) Load(l entity.Link, shortURL string) CreateLinkOutput {
 	o.URL = l.URL
 	o.Alias = l.Alias
-	o.ExpiredAt = l.ExpiredAt
+	o.ShortURL = shortURL
+	o.ExpiredAt = optionalTime(l.ExpiredAt)
+	o.RedirectCode = redirectCode(l.RedirectCode)
+	o.ForwardQuery = l.ForwardQuery
+	o.PasswordProtected = l.IsProtected()
+	o.MaxClicks = l.MaxClicks
 
 	return o
 }
@@ -44,3 +121,69 @@ func (o CreateLinkOutput) Str() string {
 	}
 	return string(b)
 }
+
+type CheckAliasInput struct {
+	Alias string `json:"alias"`
+}
+
+func (i CheckAliasInput) Validate() error {
+	if !aliasPattern.MatchString(i.Alias) {
+		return entity.NewValidationError("alias", aliasDescription)
+	}
+	if entity.IsReservedAlias(i.Alias) {
+		return entity.NewValidationError("alias", "is reserved")
+	}
+	return nil
+}
+
+type CheckAliasOutput struct {
+	Alias     string `json:"alias"`
+	Available bool   `json:"available"`
+}
+
+// MaxBatchSize limits the number of links created at once.
+const MaxBatchSize = 1000
+
+// MaxBatchPasswords limits the password-protected links of a batch, each password is hashed
+// with bcrypt that takes tens of milliseconds, so a batch of them would hold the request for a long time.
+const MaxBatchPasswords = 10
+
+type CreateLinksInput struct {
+	Links []CreateLinkInput `json:"links"`
+}
+
+// Validate checks the size of the batch and the number of passwords, the links are validated one by one
+// on creation, so an invalid link doesn't fail the whole batch.
+func (i CreateLinksInput) Validate() error {
+	if len(i.Links) == 0 || len(i.Links) > MaxBatchSize {
+		return entity.NewValidationError("links", fmt.Sprintf("must contain 1-%d links", MaxBatchSize))
+	}
+
+	passwords := 0
+	for _, l := range i.Links {
+		if l.Password != "" {
+			passwords++
+		}
+	}
+	if passwords > MaxBatchPasswords {
+		return entity.NewValidationError("links", fmt.Sprintf("must contain at most %d password-protected links", MaxBatchPasswords))
+	}
+
+	return nil
+}
+
+// CreateLinksItem is the result of creating a link of the batch, either the link or the error is set.
+type CreateLinksItem struct {
+	Index int               `json:"index"` // position of the link in the input
+	Link  *CreateLinkOutput `json:"link,omitempty"`
+	// Existing is set in dedup mode when the link of the same URL is returned instead of a new one
+	Existing bool   `json:"existing,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Err      error  `json:"-"`
+}
+
+type CreateLinksOutput struct {
+	Created int               `json:"created"` // the existing links returned in dedup mode are not counted
+	Failed  int               `json:"failed"`
+	Links   []CreateLinksItem `json:"links"`
+}
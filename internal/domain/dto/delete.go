@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+type DeleteLinkInput struct {
+	Alias string `json:"alias"`
+}
+
+func (i DeleteLinkInput) Validate() error {
+	if len(i.Alias) < 2 {
+		return entity.NewValidationError("alias", "must be at least 2 characters long")
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
@@ -12,21 +13,69 @@ type FetchLinkInput struct {
 
 func (i FetchLinkInput) Validate() error {
 	if len(i.Alias) < 2 {
-		return entity.ErrInputValidation
+		return entity.NewValidationError("alias", "must be at least 2 characters long")
+	}
+	return nil
+}
+
+// UnlockLinkInput opens a password-protected link.
+type UnlockLinkInput struct {
+	Alias    string
+	Password string
+	Client   string // failed attempts are throttled per link and client
+}
+
+func (i UnlockLinkInput) Validate() error {
+	if len(i.Alias) < 2 {
+		return entity.NewValidationError("alias", "must be at least 2 characters long")
 	}
 	return nil
 }
 
 type FetchLinkOutput struct {
-	URL       string    `json:"url"`
-	Alias     string    `json:"alias"`
-	ExpiredAt time.Time `json:"expired_at"`
+	URL          string     `json:"url"`
+	Alias        string     `json:"alias"`
+	ShortURL     string     `json:"short_url"`
+	ExpiredAt    *time.Time `json:"expired_at"`
+	RedirectCode int        `json:"redirect_code"`
+	ForwardQuery bool       `json:"forward_query"`
+	// PasswordProtected links open after the visitor enters the password
+	PasswordProtected bool `json:"password_protected"`
+	MaxClicks         int  `json:"max_clicks"` // 0 - unlimited
+	// CacheMaxAge limits caching of the redirect by the clients, set by the redirect only
+	CacheMaxAge time.Duration `json:"-"`
 }
 
-func (o FetchLinkOutput) Load(l *entity.Link) FetchLinkOutput {
+func (o FetchLinkOutput) Load(l *entity.Link, shortURL string) FetchLinkOutput {
 	o.URL = l.URL
 	o.Alias = l.Alias
-	o.ExpiredAt = l.ExpiredAt
+	o.ShortURL = shortURL
+	o.ExpiredAt = optionalTime(l.ExpiredAt)
+	o.RedirectCode = redirectCode(l.RedirectCode)
+	o.ForwardQuery = l.ForwardQuery
+	o.PasswordProtected = l.IsProtected()
+	o.MaxClicks = l.MaxClicks
+
+	// the target of a protected link is only revealed by the password
+	if o.PasswordProtected {
+		o.URL = ""
+	}
 
 	return o
 }
+
+// optionalTime returns nil for zero time, e.g. for links that never expire.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// redirectCode returns 302 Found for links without the redirect code.
+func redirectCode(code int) int {
+	if code == 0 {
+		return http.StatusFound
+	}
+	return code
+}
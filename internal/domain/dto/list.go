@@ -0,0 +1,175 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// maxListLimit limits the size of a page of links.
+const maxListLimit = 100
+
+// maxDomainLength is the max length of a domain name.
+const maxDomainLength = 253
+
+type ListLinksInput struct {
+	OwnerID     uuid.UUID // any owner if not set, authenticated callers always list their own links
+	CreatedFrom time.Time // inclusive
+	CreatedTo   time.Time // exclusive
+	State       string    // active or expired, any if not set
+	Domain      string    // substring of the target host
+	Sort        string    // created_at or expired_at, created_at if not set
+	Order       string    // asc or desc, desc if not set
+	Cursor      string    // next_cursor of the previous page
+	Limit       int       // size of the page, 50 if not set
+}
+
+func (i ListLinksInput) Validate() error {
+	if i.State != "" && i.State != entity.LinkStateActive && i.State != entity.LinkStateExpired {
+		return entity.NewValidationError("state", "must be one of active, expired")
+	}
+	if i.Sort != "" && i.Sort != entity.LinkSortCreatedAt && i.Sort != entity.LinkSortExpiredAt {
+		return entity.NewValidationError("sort", "must be one of created_at, expired_at")
+	}
+	if i.Order != "" && i.Order != OrderAsc && i.Order != OrderDesc {
+		return entity.NewValidationError("order", "must be one of asc, desc")
+	}
+	if !i.CreatedFrom.IsZero() && !i.CreatedTo.IsZero() && !i.CreatedFrom.Before(i.CreatedTo) {
+		return entity.NewValidationError("created_to", "must be after created_from")
+	}
+	if len(i.Domain) > maxDomainLength {
+		return entity.NewValidationError("domain", fmt.Sprintf("must be at most %d characters long", maxDomainLength))
+	}
+	if i.Limit < 0 || i.Limit > maxListLimit {
+		return entity.NewValidationError("limit", fmt.Sprintf("must be between 1 and %d", maxListLimit))
+	}
+	if _, err := i.after(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Filter returns the link filter of the input with the defaults applied.
+func (i ListLinksInput) Filter(defaultLimit int) (entity.LinkFilter, error) {
+	f := entity.LinkFilter{
+		OwnerID:     i.OwnerID,
+		CreatedFrom: i.CreatedFrom,
+		CreatedTo:   i.CreatedTo,
+		State:       i.State,
+		Domain:      i.Domain,
+		SortBy:      i.Sort,
+		Desc:        i.Order != OrderAsc,
+		Limit:       i.Limit,
+	}
+	if f.SortBy == "" {
+		f.SortBy = entity.LinkSortCreatedAt
+	}
+	if f.Limit == 0 {
+		f.Limit = defaultLimit
+	}
+
+	var err error
+	if f.After, err = i.after(); err != nil {
+		return f, err
+	}
+
+	return f, nil
+}
+
+// listCursor is the opaque position of the last link of a page. It remembers the order
+// of the list, so a cursor can't be used with another sort.
+type listCursor struct {
+	Sort string     `json:"s"`
+	Desc bool       `json:"d"`
+	Time *time.Time `json:"t,omitempty"` // nil for links that never expire
+	ID   uuid.UUID  `json:"id"`
+}
+
+func (i ListLinksInput) after() (*entity.LinkCursor, error) {
+	if i.Cursor == "" {
+		return nil, nil // the first page
+	}
+
+	invalid := entity.NewValidationError("cursor", "must be the next_cursor of the previous page")
+
+	data, err := base64.RawURLEncoding.DecodeString(i.Cursor)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var c listCursor
+	if err = json.Unmarshal(data, &c); err != nil || c.ID == uuid.Nil {
+		return nil, invalid
+	}
+
+	sort := i.Sort
+	if sort == "" {
+		sort = entity.LinkSortCreatedAt
+	}
+	if c.Sort != sort || c.Desc != (i.Order != OrderAsc) {
+		return nil, entity.NewValidationError("cursor", "was issued for another sort or order")
+	}
+
+	after := &entity.LinkCursor{ID: c.ID}
+	if c.Time != nil {
+		after.Time = *c.Time
+	}
+
+	return after, nil
+}
+
+func encodeCursor(f entity.LinkFilter, l entity.Link) string {
+	pos := f.Cursor(l)
+	data, _ := json.Marshal(listCursor{
+		Sort: f.SortBy,
+		Desc: f.Desc,
+		Time: optionalTime(pos.Time),
+		ID:   pos.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+type ListLinksItem struct {
+	FetchLinkOutput
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListLinksOutput struct {
+	Links      []ListLinksItem `json:"links"`
+	NextCursor string          `json:"next_cursor,omitempty"` // empty on the last page
+}
+
+// Load fills the page with the links, hasMore tells whether there are links after the last one.
+// The targets of protected links are revealed only to their owner.
+func (o ListLinksOutput) Load(
+	f entity.LinkFilter, links []entity.Link, hasMore bool, owner uuid.UUID, shortURL func(alias string) string,
+) ListLinksOutput {
+	o.Links = make([]ListLinksItem, 0, len(links))
+	for i := range links {
+		l := &links[i]
+		item := ListLinksItem{
+			FetchLinkOutput: FetchLinkOutput{}.Load(l, shortURL(l.Alias)),
+			CreatedAt:       l.CreatedAt,
+		}
+		if l.IsOwnedBy(owner) {
+			item.URL = l.URL
+		}
+		o.Links = append(o.Links, item)
+	}
+
+	if hasMore && len(links) > 0 {
+		o.NextCursor = encodeCursor(f, links[len(links)-1])
+	}
+
+	return o
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+type ReapLinksInput struct {
+	Before    time.Time `json:"before"`
+	BatchSize int       `json:"batch_size"`
+}
+
+type ReapLinksOutput struct {
+	Reaped int `json:"reaped"`
+}
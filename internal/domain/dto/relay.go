@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type RelayEventsInput struct {
+	BatchSize int `json:"batch_size"`
+}
+
+type RelayEventsOutput struct {
+	Published int           `json:"published"`
+	Pending   int           `json:"pending"` // events left in the outbox
+	Lag       time.Duration `json:"lag"`     // age of the oldest pending event
+}
@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type RollupClicksInput struct {
+	Before      time.Time `json:"before"`       // raw clicks made before are counted into the rollups
+	RetainAfter time.Time `json:"retain_after"` // older raw clicks are deleted, zero keeps all raw clicks
+	BatchSize   int       `json:"batch_size"`
+}
+
+type RollupClicksOutput struct {
+	Deleted int64 `json:"deleted"`
+}
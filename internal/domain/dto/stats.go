@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// maxTop limits the size of the breakdowns.
+const maxTop = 100
+
+type RecordClickInput struct {
+	Alias     string
+	ClickedAt time.Time
+	IP        string
+	Referrer  string
+	UserAgent string
+}
+
+type LinkStatsInput struct {
+	Alias string `json:"alias"`
+	Top   int    `json:"top" query:"top"` // size of the breakdowns, 10 if not set
+}
+
+func (i LinkStatsInput) Validate() error {
+	if len(i.Alias) < 2 {
+		return entity.NewValidationError("alias", "must be at least 2 characters long")
+	}
+	if i.Top < 0 || i.Top > maxTop {
+		return entity.NewValidationError("top", fmt.Sprintf("must be between 1 and %d", maxTop))
+	}
+	return nil
+}
+
+type StatsEntry struct {
+	Value  string `json:"value"` // empty if unknown
+	Clicks int64  `json:"clicks"`
+}
+
+type LinkStatsOutput struct {
+	Alias        string     `json:"alias"`
+	TotalClicks  int64      `json:"total_clicks"`
+	FirstClickAt *time.Time `json:"first_click_at"`
+	LastClickAt  *time.Time `json:"last_click_at"`
+	ClicksDay    int64      `json:"clicks_day"`   // last 24 hours
+	ClicksWeek   int64      `json:"clicks_week"`  // last 7 days
+	ClicksMonth  int64      `json:"clicks_month"` // last 30 days
+
+	Countries []StatsEntry `json:"countries"`
+	Referrers []StatsEntry `json:"referrers"`
+	Browsers  []StatsEntry `json:"browsers"`
+	OS        []StatsEntry `json:"os"`
+	Devices   []StatsEntry `json:"devices"`
+}
+
+func (o LinkStatsOutput) Load(alias string, s entity.LinkStats) LinkStatsOutput {
+	o.Alias = alias
+	o.TotalClicks = s.TotalClicks
+	o.FirstClickAt = optionalTime(s.FirstClickAt)
+	o.LastClickAt = optionalTime(s.LastClickAt)
+	o.ClicksDay = s.ClicksDay
+	o.ClicksWeek = s.ClicksWeek
+	o.ClicksMonth = s.ClicksMonth
+	o.Countries = statsEntries(s.Countries)
+	o.Referrers = statsEntries(s.Referrers)
+	o.Browsers = statsEntries(s.Browsers)
+	o.OS = statsEntries(s.OS)
+	o.Devices = statsEntries(s.Devices)
+
+	return o
+}
+
+func statsEntries(entries []entity.StatsEntry) []StatsEntry {
+	res := make([]StatsEntry, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, StatsEntry{Value: e.Value, Clicks: e.Clicks})
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+const (
+	IntervalHour = "hour"
+	IntervalDay  = "day"
+	IntervalWeek = "week"
+)
+
+// maxBuckets limits the size of the histogram.
+const maxBuckets = 1000
+
+var intervalDurations = map[string]time.Duration{
+	IntervalHour: time.Hour,
+	IntervalDay:  24 * time.Hour,
+	IntervalWeek: 7 * 24 * time.Hour,
+}
+
+type LinkTimeseriesInput struct {
+	Alias    string
+	From     time.Time // inclusive
+	To       time.Time // exclusive
+	Interval string    // hour, day or week
+	TimeZone string    // IANA name, UTC if not set
+}
+
+func (i LinkTimeseriesInput) Validate() error {
+	if len(i.Alias) < 2 {
+		return entity.NewValidationError("alias", "must be at least 2 characters long")
+	}
+	d, ok := intervalDurations[i.Interval]
+	if !ok {
+		return entity.NewValidationError("interval", "must be one of hour, day, week")
+	}
+	if i.From.IsZero() || i.To.IsZero() {
+		return entity.NewValidationError("from", "from and to are required")
+	}
+	if !i.From.Before(i.To) {
+		return entity.NewValidationError("to", "must be after from")
+	}
+	if i.To.Sub(i.From)/d > maxBuckets {
+		return entity.NewValidationError("interval", fmt.Sprintf("must produce at most %d buckets", maxBuckets))
+	}
+	if _, err := time.LoadLocation(i.TimeZone); err != nil {
+		return entity.NewValidationError("tz", "must be an IANA time zone name")
+	}
+	return nil
+}
+
+type TimeseriesBucket struct {
+	Start  time.Time `json:"start"` // in the requested time zone
+	Clicks int64     `json:"clicks"`
+}
+
+type LinkTimeseriesOutput struct {
+	Alias    string             `json:"alias"`
+	Interval string             `json:"interval"`
+	TimeZone string             `json:"tz"`
+	Buckets  []TimeseriesBucket `json:"buckets"`
+}
+
+func (o LinkTimeseriesOutput) Load(alias string, input LinkTimeseriesInput, buckets []entity.ClickBucket) LinkTimeseriesOutput {
+	o.Alias = alias
+	o.Interval = input.Interval
+	o.TimeZone = input.TimeZone
+	if o.TimeZone == "" {
+		o.TimeZone = "UTC"
+	}
+	o.Buckets = make([]TimeseriesBucket, 0, len(buckets))
+	for _, b := range buckets {
+		o.Buckets = append(o.Buckets, TimeseriesBucket{Start: b.Start, Clicks: b.Clicks})
+	}
+
+	return o
+}
@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// UpdateLinkInput changes the URL and/or expiration of a link, unset fields are left unchanged.
+type UpdateLinkInput struct {
+	Alias        string     `json:"-"`
+	URL          string     `json:"url,omitempty"`
+	ExpiresIn    int64      `json:"expires_in,omitempty"` // seconds
+	ExpiredAt    *time.Time `json:"expired_at,omitempty"`
+	NeverExpires bool       `json:"never_expires,omitempty"`
+}
+
+// Expiration returns the requested expiration of the link.
+func (i *UpdateLinkInput) Expiration() entity.Expiration {
+	return entity.Expiration{ExpiresIn: i.ExpiresIn, ExpiredAt: i.ExpiredAt, NeverExpires: i.NeverExpires}
+}
+
+// Validate checks the input and normalizes the URL.
+func (i *UpdateLinkInput) Validate() error {
+	if len(i.Alias) < 2 {
+		return entity.NewValidationError("alias", "must be at least 2 characters long")
+	}
+
+	if i.ExpiresIn < 0 {
+		return entity.NewValidationError("expires_in", "must not be negative")
+	}
+	if i.ExpiresIn > MaxExpiresIn {
+		return entity.NewValidationError("expires_in", fmt.Sprintf("must not exceed %d seconds", MaxExpiresIn))
+	}
+
+	expirations := 0
+	for _, isSet := range []bool{i.ExpiresIn > 0, i.ExpiredAt != nil, i.NeverExpires} {
+		if isSet {
+			expirations++
+		}
+	}
+	if expirations > 1 {
+		return entity.NewValidationError("expires_in", "only one of expires_in, expired_at and never_expires may be set")
+	}
+
+	if i.URL == "" && expirations == 0 {
+		return entity.NewValidationError("url", "url or expiration must be set")
+	}
+
+	if i.URL != "" {
+		url, err := entity.NormalizeURL(i.URL)
+		if err != nil {
+			return err
+		}
+		i.URL = url
+	}
+
+	return nil
+}
+
+// HasExpiration reports whether the input changes the link expiration.
+func (i *UpdateLinkInput) HasExpiration() bool {
+	return i.ExpiresIn > 0 || i.ExpiredAt != nil || i.NeverExpires
+}
+
+type UpdateLinkOutput struct {
+	URL          string     `json:"url"`
+	Alias        string     `json:"alias"`
+	ShortURL     string     `json:"short_url"`
+	ExpiredAt    *time.Time `json:"expired_at"`
+	RedirectCode int        `json:"redirect_code"`
+	ForwardQuery bool       `json:"forward_query"`
+	// PasswordProtected links open after the visitor enters the password
+	PasswordProtected bool `json:"password_protected"`
+	MaxClicks         int  `json:"max_clicks"` // 0 - unlimited
+}
+
+func (o UpdateLinkOutput) Load(l entity.Link, shortURL string) UpdateLinkOutput {
+	o.URL = l.URL
+	o.Alias = l.Alias
+	o.ShortURL = shortURL
+	o.ExpiredAt = optionalTime(l.ExpiredAt)
+	o.RedirectCode = redirectCode(l.RedirectCode)
+	o.ForwardQuery = l.ForwardQuery
+	o.PasswordProtected = l.IsProtected()
+	o.MaxClicks = l.MaxClicks
+
+	// the target of a protected link is only revealed by the password
+	if o.PasswordProtected {
+		o.URL = ""
+	}
+
+	return o
+}
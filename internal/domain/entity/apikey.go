@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const apiKeyPrefix = "sk_"
+
+// APIKey authenticates the clients of the API on behalf of the owner.
+// An owner may have several keys, e.g. to rotate them without downtime.
+type APIKey struct {
+	ID        uuid.UUID
+	OwnerID   uuid.UUID
+	Name      string
+	Hash      string // sha256 of the key, the key itself is not stored
+	CreatedAt time.Time
+	RevokedAt time.Time // zero value means that the key is active
+}
+
+func (k APIKey) IsRevoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// GenerateAPIKey returns a new random key and its hash to be stored in APIKey.Hash.
+func GenerateAPIKey() (key, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("rand.Read: %w", err)
+	}
+
+	key = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hash the key is looked up by. The keys are random,
+// so a fast hash is enough unlike the link passwords.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Principal is the authenticated client of the API.
+type Principal struct {
+	KeyID   uuid.UUID
+	OwnerID uuid.UUID
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of the context carrying the principal.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal of the context, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// OwnerFromContext returns the owner of the context principal.
+// uuid.Nil means an anonymous or internal caller, e.g. the kafka consumer or the API without authentication.
+func OwnerFromContext(ctx context.Context) uuid.UUID {
+	p, _ := PrincipalFromContext(ctx)
+	return p.OwnerID
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+)
+
+// Device classes of a click, an empty class means that the device is unknown.
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceBot     = "bot"
+)
+
+// Click is a single redirect through a short link.
+// Empty dimensions (country, referrer domain, browser, OS, device) mean that they are unknown.
+type Click struct {
+	Alias     string
+	ClickedAt time.Time
+	Referrer  string
+	UserAgent string
+
+	Country        string // ISO 3166-1 alpha-2 code
+	ReferrerDomain string
+	Browser        string
+	OS             string
+	Device         string
+}
+
+// UserAgent is a parsed User-Agent header.
+type UserAgent struct {
+	Browser string
+	OS      string
+	Device  string
+}
+
+// StatsEntry is the number of clicks with the same dimension value.
+type StatsEntry struct {
+	Value  string
+	Clicks int64
+}
+
+// LinkStats aggregates the clicks of a link.
+type LinkStats struct {
+	TotalClicks  int64
+	FirstClickAt time.Time // zero value means that the link has never been clicked
+	LastClickAt  time.Time
+	ClicksDay    int64
+	ClicksWeek   int64
+	ClicksMonth  int64
+
+	// top-N breakdowns ordered by clicks
+	Countries []StatsEntry
+	Referrers []StatsEntry
+	Browsers  []StatsEntry
+	OS        []StatsEntry
+	Devices   []StatsEntry
+}
+
+// ClickBucket is the number of clicks made in the time bucket starting at Start.
+type ClickBucket struct {
+	Start  time.Time
+	Clicks int64
+}
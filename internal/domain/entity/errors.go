@@ -1,10 +1,40 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrNotFound         = errors.New("not found")
+	ErrExpired          = errors.New("expired")
 	ErrAlreadyExist     = errors.New("entity already exists")
 	ErrEntityValidation = errors.New("invalid entity")
 	ErrInputValidation  = errors.New("invalid input")
+	ErrInvalidPassword  = errors.New("invalid password")
+	ErrTooManyAttempts  = errors.New("too many attempts")
+	ErrExhausted        = errors.New("exhausted")
+	ErrBlocked          = errors.New("blocked")
+	ErrUnauthorized     = errors.New("unauthorized")
+
+	// ErrAliasTaken is returned when another link already uses the alias.
+	ErrAliasTaken = fmt.Errorf("alias taken: %w", ErrAlreadyExist)
 )
+
+// ValidationError describes an invalid input field. It matches ErrInputValidation.
+type ValidationError struct {
+	Field       string
+	Description string
+}
+
+func NewValidationError(field, description string) *ValidationError {
+	return &ValidationError{Field: field, Description: description}
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", ErrInputValidation, e.Field, e.Description)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInputValidation
+}
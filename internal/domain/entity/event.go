@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	LinkEventCreated = "created"
+	LinkEventUpdated = "updated"
+	LinkEventDeleted = "deleted"
+)
+
+// LinkEvent is a change of a link. Events are stored in the outbox in the same transaction
+// as the change and published to the broker by the relay, so they are never lost.
+type LinkEvent struct {
+	ID        int64     // position in the outbox
+	EventID   uuid.UUID // identifier passed to the consumers, the same for redeliveries
+	Event     string
+	Alias     string
+	URL       string
+	ExpiredAt time.Time // zero value means that the link never expires
+	CreatedAt time.Time
+	Attempts  int // failed attempts to publish the event
+}
+
+func NewLinkEvent(event string, l Link) LinkEvent {
+	return LinkEvent{EventID: uuid.New(), Event: event, Alias: l.Alias, URL: l.URL, ExpiredAt: l.ExpiredAt}
+}
+
+// OutboxStats describes the events waiting to be published.
+type OutboxStats struct {
+	Pending  int
+	OldestAt time.Time // creation time of the oldest pending event, zero if there are none
+}
@@ -0,0 +1,51 @@
+package entity
+
+import "time"
+
+// Lifetime is the expiration policy of links, shared by their creation and update.
+type Lifetime struct {
+	DefaultTTL time.Duration // lifetime of the links created without expiration
+	MaxTTL     time.Duration // 0 - unlimited, never-expiring links are allowed
+}
+
+// Expiration is the requested expiration of a link, at most one of the fields is set.
+type Expiration struct {
+	ExpiresIn    int64 // seconds
+	ExpiredAt    *time.Time
+	NeverExpires bool
+}
+
+// ExpiredAt applies the policy to the requested expiration, the default TTL is used if nothing is requested.
+// Zero time means that the link never expires.
+func (l Lifetime) ExpiredAt(e Expiration, now time.Time) (time.Time, error) {
+	ttl := l.DefaultTTL
+
+	switch {
+	case e.NeverExpires:
+		if l.MaxTTL > 0 {
+			return time.Time{}, NewValidationError("never_expires", "never-expiring links are not allowed")
+		}
+		return time.Time{}, nil
+	case e.ExpiredAt != nil:
+		ttl = e.ExpiredAt.Sub(now)
+	case e.ExpiresIn > 0:
+		ttl = time.Duration(e.ExpiresIn) * time.Second
+	}
+
+	if ttl <= 0 {
+		return time.Time{}, NewValidationError("expired_at", "must be in the future")
+	}
+
+	if l.MaxTTL > 0 && ttl > l.MaxTTL {
+		return time.Time{}, NewValidationError("expired_at", "must not exceed the max link lifetime "+l.MaxTTL.String())
+	}
+
+	return now.Add(ttl), nil
+}
+
+// LinkChanges are the fields of a link to update, the fields that are not set are left unchanged.
+type LinkChanges struct {
+	URL       string    // empty - unchanged
+	SetExpiry bool      // change ExpiredAt
+	ExpiredAt time.Time // zero value means that the link never expires
+}
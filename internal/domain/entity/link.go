@@ -1,14 +1,97 @@
 package entity
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type Link struct {
-	ID        uuid.UUID
-	URL       string
-	Alias     string
-	ExpiredAt time.Time
+	ID           uuid.UUID
+	URL          string
+	Alias        string
+	ExpiredAt    time.Time // zero value means that the link never expires
+	RedirectCode int       // zero value means 302 Found, e.g. for links cached before the field was added
+	ForwardQuery bool      // merge the query string of the redirect request into the URL
+	PasswordHash string    // bcrypt hash, empty for links without password
+	MaxClicks    int       // redirects before the link stops working, 0 - unlimited
+	Blocked      bool      // the URL matches the blocklist, the link is not served
+	OwnerID      uuid.UUID // owner of the API key the link is created with, uuid.Nil - no owner
+	CreatedAt    time.Time
+}
+
+func (l Link) IsExpired(now time.Time) bool {
+	return !l.ExpiredAt.IsZero() && !now.Before(l.ExpiredAt)
+}
+
+// IsOwnedBy reports whether the link belongs to the owner, links without owner belong to nobody.
+func (l Link) IsOwnedBy(owner uuid.UUID) bool {
+	return owner != uuid.Nil && l.OwnerID == owner
+}
+
+// CanBeManagedBy reports whether the owner may change, delete or inspect the link.
+// Internal callers (uuid.Nil) are not restricted, links without owner are managed only by them.
+func (l Link) CanBeManagedBy(owner uuid.UUID) bool {
+	return owner == uuid.Nil || l.OwnerID == owner
+}
+
+func (l Link) IsLimited() bool {
+	return l.MaxClicks > 0
+}
+
+func (l Link) IsProtected() bool {
+	return l.PasswordHash != ""
+}
+
+// HashPassword returns the hash of the password to be stored in Link.PasswordHash.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("bcrypt.GenerateFromPassword: %w", err)
+	}
+	return string(hash), nil
+}
+
+// CheckPassword reports whether the password opens the link, links without password open with any.
+func (l Link) CheckPassword(password string) bool {
+	if !l.IsProtected() {
+		return true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(l.PasswordHash), []byte(password)) == nil
+}
+
+// IsValidRedirectCode reports whether links may redirect with the status code.
+func IsValidRedirectCode(code int) bool {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPermanentRedirect reports whether clients may cache the redirect.
+func IsPermanentRedirect(code int) bool {
+	return code == http.StatusMovedPermanently || code == http.StatusPermanentRedirect
+}
+
+// reservedAliases collide with the built-in root routes, short links are served on the root too.
+var reservedAliases = map[string]struct{}{
+	"api":     {},
+	"debug":   {},
+	"live":    {},
+	"metrics": {},
+	"ready":   {},
+	"swagger": {},
+}
+
+// IsReservedAlias reports whether the alias is taken by a built-in route.
+// Routes are case-insensitive, so is the check.
+func IsReservedAlias(alias string) bool {
+	_, ok := reservedAliases[strings.ToLower(alias)]
+	return ok
 }
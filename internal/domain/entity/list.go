@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sort keys of the link list.
+const (
+	LinkSortCreatedAt = "created_at"
+	LinkSortExpiredAt = "expired_at"
+)
+
+// States of the link list filter, an empty state matches any link.
+const (
+	LinkStateActive  = "active"
+	LinkStateExpired = "expired"
+)
+
+// LinkFilter selects a page of links ordered by SortBy and ID.
+type LinkFilter struct {
+	OwnerID     uuid.UUID // uuid.Nil matches any owner
+	CreatedFrom time.Time // inclusive, zero value means unbounded
+	CreatedTo   time.Time // exclusive, zero value means unbounded
+	State       string
+	Domain      string // substring of the target host
+	SortBy      string
+	Desc        bool
+	After       *LinkCursor // position of the last link of the previous page
+	Limit       int
+}
+
+// LinkCursor is the position of a link in the list. Links that never expire
+// have zero Time and go after all other links in the expired_at order.
+type LinkCursor struct {
+	Time time.Time
+	ID   uuid.UUID
+}
+
+// Cursor returns the position of the link in the list ordered by the filter.
+func (f LinkFilter) Cursor(l Link) LinkCursor {
+	if f.SortBy == LinkSortExpiredAt {
+		return LinkCursor{Time: l.ExpiredAt, ID: l.ID}
+	}
+	return LinkCursor{Time: l.CreatedAt, ID: l.ID}
+}
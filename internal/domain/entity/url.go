@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// MaxURLLength is the max length of a link URL, longer URLs are not supported by some browsers.
+const MaxURLLength = 2048
+
+var allowedSchemes = map[string]string{"http": "80", "https": "443"} // scheme: default port
+
+// NormalizeURL validates the target URL of a link and returns its canonical form:
+// lowercase scheme and host, IDN hosts in punycode, no default port and no trailing dot of the host.
+// Only absolute http(s) URLs are allowed, without credentials and not pointing at
+// loopback, private, link-local or unspecified addresses. Host names are not resolved.
+func NormalizeURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+
+	switch {
+	case raw == "":
+		return "", NewValidationError("url", "must not be empty")
+	case len(raw) > MaxURLLength:
+		return "", NewValidationError("url", fmt.Sprintf("must be at most %d bytes long", MaxURLLength))
+	case strings.ContainsFunc(raw, isInvalidURLRune):
+		return "", NewValidationError("url", "must not contain whitespace or control characters")
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", NewValidationError("url", "must be a valid URL")
+	}
+
+	defaultPort, ok := allowedSchemes[u.Scheme]
+	if !ok {
+		return "", NewValidationError("url", "scheme must be http or https")
+	}
+
+	if u.Opaque != "" || u.Host == "" {
+		return "", NewValidationError("url", "must have a host")
+	}
+
+	if u.User != nil {
+		return "", NewValidationError("url", "must not contain credentials")
+	}
+
+	host, err := normalizeHost(u.Hostname())
+	if err != nil {
+		return "", err
+	}
+
+	port := u.Port()
+	if port == "" && strings.HasSuffix(u.Host, ":") {
+		return "", NewValidationError("url", "port must not be empty")
+	}
+	if port == defaultPort {
+		port = ""
+	}
+
+	u.Host = host
+	if strings.Contains(host, ":") {
+		u.Host = "[" + host + "]"
+	}
+	if port != "" {
+		u.Host += ":" + port
+	}
+
+	normalized := u.String()
+	if len(normalized) > MaxURLLength {
+		return "", NewValidationError("url", fmt.Sprintf("must be at most %d bytes long", MaxURLLength))
+	}
+
+	return normalized, nil
+}
+
+// normalizeHost returns the lowercase ASCII form of the host and rejects local addresses.
+func normalizeHost(host string) (string, error) {
+	host = strings.TrimSuffix(host, ".")
+	if host == "" {
+		return "", NewValidationError("url", "must have a host")
+	}
+
+	if ip, err := netip.ParseAddr(host); err == nil {
+		if isLocalAddr(ip) {
+			return "", NewValidationError("url", "must not point at a local or private address")
+		}
+		return ip.String(), nil
+	}
+
+	ascii, err := idna.Lookup.ToASCII(host)
+	if err != nil {
+		return "", NewValidationError("url", "host must be a valid domain name")
+	}
+
+	// browsers read hosts like 2130706433, 0x7f.1 or 127.1 as IPv4 addresses
+	labels := strings.Split(ascii, ".")
+	if isNumericLabel(labels[len(labels)-1]) {
+		return "", NewValidationError("url", "host must be a valid domain name or IP address")
+	}
+
+	if ascii == "localhost" || strings.HasSuffix(ascii, ".localhost") {
+		return "", NewValidationError("url", "must not point at a local or private address")
+	}
+
+	return ascii, nil
+}
+
+func isLocalAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// isNumericLabel reports whether the label is a decimal or 0x-prefixed hex number.
+func isNumericLabel(label string) bool {
+	digits := "0123456789"
+	if rest, ok := strings.CutPrefix(strings.ToLower(label), "0x"); ok {
+		label, digits = rest, "0123456789abcdef"
+	}
+	for _, r := range label {
+		if !strings.ContainsRune(digits, r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isInvalidURLRune(r rune) bool {
+	return r <= ' ' || r == 0x7f
+}
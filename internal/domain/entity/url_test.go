@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	testCases := []struct {
+		name    string
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{name: "Canonical", url: "https://example.com/path?q=1#top", want: "https://example.com/path?q=1#top"},
+		{name: "Case and default port", url: " HTTPS://Example.COM:443/Path ", want: "https://example.com/Path"},
+		{name: "Custom port", url: "http://example.com.:8080/", want: "http://example.com:8080/"},
+		{name: "IDN host", url: "http://пример.рф/", want: "http://xn--e1afmkfd.xn--p1ai/"},
+		{name: "Public IP", url: "http://8.8.8.8/", want: "http://8.8.8.8/"},
+		{name: "Public IPv6", url: "http://[2001:4860:4860::8888]:80/", want: "http://[2001:4860:4860::8888]/"},
+		{name: "Hex-like TLD", url: "https://cafe.de", want: "https://cafe.de"},
+		{name: "Empty", url: "", wantErr: true},
+		{name: "Too long", url: "https://example.com/" + strings.Repeat("a", MaxURLLength), wantErr: true},
+		{name: "Bare word", url: "example", wantErr: true},
+		{name: "Javascript", url: "javascript:alert(1)", wantErr: true},
+		{name: "FTP", url: "ftp://example.com/file", wantErr: true},
+		{name: "No host", url: "http:///path", wantErr: true},
+		{name: "Credentials", url: "https://google.com@evil.com/", wantErr: true},
+		{name: "Whitespace", url: "https://example.com/a b", wantErr: true},
+		{name: "Invalid domain", url: "https://exa mple.com", wantErr: true},
+		{name: "Localhost", url: "http://localhost:8000/", wantErr: true},
+		{name: "Loopback", url: "http://127.0.0.1/", wantErr: true},
+		{name: "Loopback IPv6", url: "http://[::1]/", wantErr: true},
+		{name: "Mapped loopback", url: "http://[::ffff:127.0.0.1]/", wantErr: true},
+		{name: "Decimal loopback", url: "http://2130706433/", wantErr: true},
+		{name: "Hex loopback", url: "http://0x7f.1/", wantErr: true},
+		{name: "Private", url: "http://10.0.0.1/", wantErr: true},
+		{name: "Link-local", url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{name: "Unspecified", url: "http://0.0.0.0/", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NormalizeURL(tc.url)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrInputValidation)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
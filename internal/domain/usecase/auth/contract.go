@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	CreateAPIKey(ctx context.Context, key entity.APIKey) error
+	FindAPIKey(ctx context.Context, hash string) (*entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_auth is a generated GoMock package.
+package mock_auth
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// Mockdatabase is a mock of database interface.
+type Mockdatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockdatabaseMockRecorder is the mock recorder for Mockdatabase.
+type MockdatabaseMockRecorder struct {
+	mock *Mockdatabase
+}
+
+// NewMockdatabase creates a new mock instance.
+func NewMockdatabase(ctrl *gomock.Controller) *Mockdatabase {
+	mock := &Mockdatabase{ctrl: ctrl}
+	mock.recorder = &MockdatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *Mockdatabase) CreateAPIKey(ctx context.Context, key entity.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockdatabaseMockRecorder) CreateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*Mockdatabase)(nil).CreateAPIKey), ctx, key)
+}
+
+// FindAPIKey mocks base method.
+func (m *Mockdatabase) FindAPIKey(ctx context.Context, hash string) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPIKey", ctx, hash)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPIKey indicates an expected call of FindAPIKey.
+func (mr *MockdatabaseMockRecorder) FindAPIKey(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPIKey", reflect.TypeOf((*Mockdatabase)(nil).FindAPIKey), ctx, hash)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *Mockdatabase) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockdatabaseMockRecorder) RevokeAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*Mockdatabase)(nil).RevokeAPIKey), ctx, id)
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+type Config struct {
+	// Enabled requires an API key for the HTTP and gRPC API, redirects are always anonymous
+	Enabled bool `env:"AUTH_ENABLED, default=true"`
+}
+
+type Usecase struct {
+	config   Config
+	database database
+}
+
+func New(cfg Config, d database) Usecase {
+	return Usecase{config: cfg, database: d}
+}
+
+// Enabled reports whether the API requires a key.
+func (u *Usecase) Enabled() bool {
+	return u.config.Enabled
+}
+
+// Authenticate returns a copy of the context carrying the principal of the key.
+// entity.ErrUnauthorized is returned for unknown and revoked keys.
+func (u *Usecase) Authenticate(ctx context.Context, key string) (context.Context, error) {
+	spanCtx, span := tracer.Start(ctx, "usecase Authenticate")
+	defer span.End()
+
+	apiKey, err := u.database.FindAPIKey(spanCtx, entity.HashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return ctx, entity.ErrUnauthorized
+		}
+		return ctx, fmt.Errorf("u.database.FindAPIKey: %w", err)
+	}
+
+	return entity.WithPrincipal(ctx, entity.Principal{KeyID: apiKey.ID, OwnerID: apiKey.OwnerID}), nil
+}
+
+// CreateKey creates a key, the key itself is only returned here.
+func (u *Usecase) CreateKey(ctx context.Context, input dto.CreateAPIKeyInput) (dto.CreateAPIKeyOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase CreateAPIKey")
+	defer span.End()
+
+	var output dto.CreateAPIKeyOutput
+
+	key, hash, err := entity.GenerateAPIKey()
+	if err != nil {
+		return output, fmt.Errorf("entity.GenerateAPIKey: %w", err)
+	}
+
+	apiKey := entity.APIKey{
+		ID:        uuid.New(),
+		OwnerID:   input.OwnerID,
+		Name:      input.Name,
+		Hash:      hash,
+		CreatedAt: time.Now().UTC(),
+	}
+	if apiKey.OwnerID == uuid.Nil {
+		apiKey.OwnerID = uuid.New()
+	}
+
+	err = u.database.CreateAPIKey(ctx, apiKey)
+	if err != nil {
+		return output, fmt.Errorf("u.database.CreateAPIKey: %w", err)
+	}
+
+	return output.Load(apiKey, key), nil
+}
+
+// RevokeKey revokes the key, the links of its owner are kept.
+func (u *Usecase) RevokeKey(ctx context.Context, input dto.RevokeAPIKeyInput) error {
+	ctx, span := tracer.Start(ctx, "usecase RevokeAPIKey")
+	defer span.End()
+
+	err := u.database.RevokeAPIKey(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("u.database.RevokeAPIKey: %w", err)
+	}
+
+	return nil
+}
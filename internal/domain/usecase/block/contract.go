@@ -0,0 +1,24 @@
+package block
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	ListLinksAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]entity.Link, error)
+	SetLinksBlocked(ctx context.Context, blocked bool, aliases ...string) error
+}
+
+type cache interface {
+	DeleteLinks(ctx context.Context, aliases ...string) error
+}
+
+type blocklist interface {
+	IsBlocked(url string) bool
+}
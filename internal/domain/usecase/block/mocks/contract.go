@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_block is a generated GoMock package.
+package mock_block
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// Mockdatabase is a mock of database interface.
+type Mockdatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockdatabaseMockRecorder is the mock recorder for Mockdatabase.
+type MockdatabaseMockRecorder struct {
+	mock *Mockdatabase
+}
+
+// NewMockdatabase creates a new mock instance.
+func NewMockdatabase(ctrl *gomock.Controller) *Mockdatabase {
+	mock := &Mockdatabase{ctrl: ctrl}
+	mock.recorder = &MockdatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
+	return m.recorder
+}
+
+// ListLinksAfter mocks base method.
+func (m *Mockdatabase) ListLinksAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]entity.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinksAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]entity.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinksAfter indicates an expected call of ListLinksAfter.
+func (mr *MockdatabaseMockRecorder) ListLinksAfter(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinksAfter", reflect.TypeOf((*Mockdatabase)(nil).ListLinksAfter), ctx, afterID, limit)
+}
+
+// SetLinksBlocked mocks base method.
+func (m *Mockdatabase) SetLinksBlocked(ctx context.Context, blocked bool, aliases ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, blocked}
+	for _, a := range aliases {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetLinksBlocked", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLinksBlocked indicates an expected call of SetLinksBlocked.
+func (mr *MockdatabaseMockRecorder) SetLinksBlocked(ctx, blocked any, aliases ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, blocked}, aliases...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLinksBlocked", reflect.TypeOf((*Mockdatabase)(nil).SetLinksBlocked), varargs...)
+}
+
+// Mockcache is a mock of cache interface.
+type Mockcache struct {
+	ctrl     *gomock.Controller
+	recorder *MockcacheMockRecorder
+	isgomock struct{}
+}
+
+// MockcacheMockRecorder is the mock recorder for Mockcache.
+type MockcacheMockRecorder struct {
+	mock *Mockcache
+}
+
+// NewMockcache creates a new mock instance.
+func NewMockcache(ctrl *gomock.Controller) *Mockcache {
+	mock := &Mockcache{ctrl: ctrl}
+	mock.recorder = &MockcacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockcache) EXPECT() *MockcacheMockRecorder {
+	return m.recorder
+}
+
+// DeleteLinks mocks base method.
+func (m *Mockcache) DeleteLinks(ctx context.Context, aliases ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range aliases {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLinks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLinks indicates an expected call of DeleteLinks.
+func (mr *MockcacheMockRecorder) DeleteLinks(ctx any, aliases ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, aliases...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLinks", reflect.TypeOf((*Mockcache)(nil).DeleteLinks), varargs...)
+}
+
+// Mockblocklist is a mock of blocklist interface.
+type Mockblocklist struct {
+	ctrl     *gomock.Controller
+	recorder *MockblocklistMockRecorder
+	isgomock struct{}
+}
+
+// MockblocklistMockRecorder is the mock recorder for Mockblocklist.
+type MockblocklistMockRecorder struct {
+	mock *Mockblocklist
+}
+
+// NewMockblocklist creates a new mock instance.
+func NewMockblocklist(ctrl *gomock.Controller) *Mockblocklist {
+	mock := &Mockblocklist{ctrl: ctrl}
+	mock.recorder = &MockblocklistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockblocklist) EXPECT() *MockblocklistMockRecorder {
+	return m.recorder
+}
+
+// IsBlocked mocks base method.
+func (m *Mockblocklist) IsBlocked(url string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", url)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockblocklistMockRecorder) IsBlocked(url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*Mockblocklist)(nil).IsBlocked), url)
+}
@@ -0,0 +1,85 @@
+package block
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+type Usecase struct {
+	database  database
+	cache     cache
+	blocklist blocklist
+}
+
+func New(d database, c cache, b blocklist) Usecase {
+	return Usecase{database: d, cache: c, blocklist: b}
+}
+
+// Sync walks through the unexpired links in batches of input.BatchSize, blocks the links
+// matching the blocklist and unblocks the links that no longer match it.
+// Changed links are evicted from the cache.
+func (u *Usecase) Sync(ctx context.Context, input dto.SyncBlockedLinksInput) (dto.SyncBlockedLinksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase SyncBlockedLinks")
+	defer span.End()
+
+	var (
+		output dto.SyncBlockedLinksOutput
+		after  uuid.UUID
+	)
+
+	for {
+		links, err := u.database.ListLinksAfter(ctx, after, input.BatchSize)
+		if err != nil {
+			return output, fmt.Errorf("u.database.ListLinksAfter: %w", err)
+		}
+
+		var blocked, unblocked []string
+		for _, l := range links {
+			switch isBlocked := u.blocklist.IsBlocked(l.URL); {
+			case isBlocked && !l.Blocked:
+				blocked = append(blocked, l.Alias)
+			case !isBlocked && l.Blocked:
+				unblocked = append(unblocked, l.Alias)
+			}
+		}
+
+		if err = u.setBlocked(ctx, true, blocked); err != nil {
+			return output, err
+		}
+		output.Blocked += len(blocked)
+
+		if err = u.setBlocked(ctx, false, unblocked); err != nil {
+			return output, err
+		}
+		output.Unblocked += len(unblocked)
+
+		if len(links) < input.BatchSize || ctx.Err() != nil {
+			return output, nil
+		}
+		after = links[len(links)-1].ID
+	}
+}
+
+func (u *Usecase) setBlocked(ctx context.Context, blocked bool, aliases []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	err := u.database.SetLinksBlocked(ctx, blocked, aliases...)
+	if err != nil {
+		return fmt.Errorf("u.database.SetLinksBlocked: %w", err)
+	}
+
+	err = u.cache.DeleteLinks(ctx, aliases...)
+	if err != nil {
+		log.Error().Err(err).Msg("u.cache.DeleteLinks")
+	}
+
+	return nil
+}
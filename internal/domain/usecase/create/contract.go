@@ -10,12 +10,24 @@ import (
 
 type database interface {
 	CreateLink(context.Context, entity.Link) error
+	CreateLinks(context.Context, []entity.Link) ([]bool, error)
+	FindOrCreateLink(context.Context, entity.Link) (entity.Link, error)
+	FindLink(ctx context.Context, alias string, url string) (*entity.Link, error)
 }
 
 type cache interface {
 	PutLink(context.Context, entity.Link) error
+	PutLinks(ctx context.Context, links ...entity.Link) error
 }
 
-type publisher interface {
-	SendLink(ctx context.Context, link entity.Link) error
+type aliasGenerator interface {
+	Generate(ctx context.Context) (string, error)
+}
+
+type shortURLBuilder interface {
+	Build(alias string) string
+}
+
+type blocklist interface {
+	IsBlocked(url string) bool
 }
@@ -56,6 +56,51 @@ func (mr *MockdatabaseMockRecorder) CreateLink(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLink", reflect.TypeOf((*Mockdatabase)(nil).CreateLink), arg0, arg1)
 }
 
+// CreateLinks mocks base method.
+func (m *Mockdatabase) CreateLinks(arg0 context.Context, arg1 []entity.Link) ([]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLinks", arg0, arg1)
+	ret0, _ := ret[0].([]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLinks indicates an expected call of CreateLinks.
+func (mr *MockdatabaseMockRecorder) CreateLinks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLinks", reflect.TypeOf((*Mockdatabase)(nil).CreateLinks), arg0, arg1)
+}
+
+// FindLink mocks base method.
+func (m *Mockdatabase) FindLink(ctx context.Context, alias, url string) (*entity.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLink", ctx, alias, url)
+	ret0, _ := ret[0].(*entity.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLink indicates an expected call of FindLink.
+func (mr *MockdatabaseMockRecorder) FindLink(ctx, alias, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLink", reflect.TypeOf((*Mockdatabase)(nil).FindLink), ctx, alias, url)
+}
+
+// FindOrCreateLink mocks base method.
+func (m *Mockdatabase) FindOrCreateLink(arg0 context.Context, arg1 entity.Link) (entity.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrCreateLink", arg0, arg1)
+	ret0, _ := ret[0].(entity.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrCreateLink indicates an expected call of FindOrCreateLink.
+func (mr *MockdatabaseMockRecorder) FindOrCreateLink(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreateLink", reflect.TypeOf((*Mockdatabase)(nil).FindOrCreateLink), arg0, arg1)
+}
+
 // Mockcache is a mock of cache interface.
 type Mockcache struct {
 	ctrl     *gomock.Controller
@@ -94,40 +139,136 @@ func (mr *MockcacheMockRecorder) PutLink(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLink", reflect.TypeOf((*Mockcache)(nil).PutLink), arg0, arg1)
 }
 
-// Mockpublisher is a mock of publisher interface.
-type Mockpublisher struct {
+// PutLinks mocks base method.
+func (m *Mockcache) PutLinks(ctx context.Context, links ...entity.Link) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range links {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutLinks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutLinks indicates an expected call of PutLinks.
+func (mr *MockcacheMockRecorder) PutLinks(ctx any, links ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, links...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLinks", reflect.TypeOf((*Mockcache)(nil).PutLinks), varargs...)
+}
+
+// MockaliasGenerator is a mock of aliasGenerator interface.
+type MockaliasGenerator struct {
 	ctrl     *gomock.Controller
-	recorder *MockpublisherMockRecorder
+	recorder *MockaliasGeneratorMockRecorder
 	isgomock struct{}
 }
 
-// MockpublisherMockRecorder is the mock recorder for Mockpublisher.
-type MockpublisherMockRecorder struct {
-	mock *Mockpublisher
+// MockaliasGeneratorMockRecorder is the mock recorder for MockaliasGenerator.
+type MockaliasGeneratorMockRecorder struct {
+	mock *MockaliasGenerator
 }
 
-// NewMockpublisher creates a new mock instance.
-func NewMockpublisher(ctrl *gomock.Controller) *Mockpublisher {
-	mock := &Mockpublisher{ctrl: ctrl}
-	mock.recorder = &MockpublisherMockRecorder{mock}
+// NewMockaliasGenerator creates a new mock instance.
+func NewMockaliasGenerator(ctrl *gomock.Controller) *MockaliasGenerator {
+	mock := &MockaliasGenerator{ctrl: ctrl}
+	mock.recorder = &MockaliasGeneratorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockpublisher) EXPECT() *MockpublisherMockRecorder {
+func (m *MockaliasGenerator) EXPECT() *MockaliasGeneratorMockRecorder {
 	return m.recorder
 }
 
-// SendLink mocks base method.
-func (m *Mockpublisher) SendLink(ctx context.Context, link entity.Link) error {
+// Generate mocks base method.
+func (m *MockaliasGenerator) Generate(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendLink", ctx, link)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Generate", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockaliasGeneratorMockRecorder) Generate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockaliasGenerator)(nil).Generate), ctx)
+}
+
+// MockshortURLBuilder is a mock of shortURLBuilder interface.
+type MockshortURLBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockshortURLBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockshortURLBuilderMockRecorder is the mock recorder for MockshortURLBuilder.
+type MockshortURLBuilderMockRecorder struct {
+	mock *MockshortURLBuilder
+}
+
+// NewMockshortURLBuilder creates a new mock instance.
+func NewMockshortURLBuilder(ctrl *gomock.Controller) *MockshortURLBuilder {
+	mock := &MockshortURLBuilder{ctrl: ctrl}
+	mock.recorder = &MockshortURLBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshortURLBuilder) EXPECT() *MockshortURLBuilderMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockshortURLBuilder) Build(alias string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", alias)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockshortURLBuilderMockRecorder) Build(alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockshortURLBuilder)(nil).Build), alias)
+}
+
+// Mockblocklist is a mock of blocklist interface.
+type Mockblocklist struct {
+	ctrl     *gomock.Controller
+	recorder *MockblocklistMockRecorder
+	isgomock struct{}
+}
+
+// MockblocklistMockRecorder is the mock recorder for Mockblocklist.
+type MockblocklistMockRecorder struct {
+	mock *Mockblocklist
+}
+
+// NewMockblocklist creates a new mock instance.
+func NewMockblocklist(ctrl *gomock.Controller) *Mockblocklist {
+	mock := &Mockblocklist{ctrl: ctrl}
+	mock.recorder = &MockblocklistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockblocklist) EXPECT() *MockblocklistMockRecorder {
+	return m.recorder
+}
+
+// IsBlocked mocks base method.
+func (m *Mockblocklist) IsBlocked(url string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", url)
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// SendLink indicates an expected call of SendLink.
-func (mr *MockpublisherMockRecorder) SendLink(ctx, link any) *gomock.Call {
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockblocklistMockRecorder) IsBlocked(url any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLink", reflect.TypeOf((*Mockpublisher)(nil).SendLink), ctx, link)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*Mockblocklist)(nil).IsBlocked), url)
 }
@@ -2,60 +2,332 @@ package create
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
 )
 
-const linkTTL = 24 * time.Hour
+const (
+	linkTTL       = 24 * time.Hour
+	aliasAttempts = 5
+)
+
+type Config struct {
+	DefaultTTL time.Duration `env:"LINK_DEFAULT_TTL, default=24h"`
+	MaxTTL     time.Duration `env:"LINK_MAX_TTL, default=0"` // 0 - unlimited, never-expiring links are allowed
+	// Dedup returns the existing alias instead of creating a new link for an already shortened URL
+	Dedup bool `env:"LINK_DEDUP, default=false"`
+	// AliasAttempts limits retries when a generated alias is already taken
+	AliasAttempts int `env:"LINK_ALIAS_ATTEMPTS, default=5"`
+	// RedirectCode is used for links created without the redirect code
+	RedirectCode int `env:"LINK_REDIRECT_CODE, default=302"`
+}
+
+// Lifetime returns the expiration policy of the links, the update of links follows it as well.
+func (c Config) Lifetime() entity.Lifetime {
+	return entity.Lifetime{DefaultTTL: c.DefaultTTL, MaxTTL: c.MaxTTL}
+}
 
 type Usecase struct {
+	config    Config
 	database  database
 	cache     cache
-	publisher publisher
+	generator aliasGenerator
+	shortURL  shortURLBuilder
+	blocklist blocklist
 }
 
-func New(d database, c cache, p publisher) Usecase {
-	return Usecase{database: d, cache: c, publisher: p}
+func New(cfg Config, d database, c cache, g aliasGenerator, s shortURLBuilder, b blocklist) Usecase {
+	if cfg.DefaultTTL <= 0 {
+		cfg.DefaultTTL = linkTTL
+	}
+	if cfg.AliasAttempts < 1 {
+		cfg.AliasAttempts = aliasAttempts
+	}
+	if !entity.IsValidRedirectCode(cfg.RedirectCode) {
+		cfg.RedirectCode = http.StatusFound
+	}
+
+	return Usecase{config: cfg, database: d, cache: c, generator: g, shortURL: s, blocklist: b}
 }
 
 func (u *Usecase) Create(ctx context.Context, input dto.CreateLinkInput) (dto.CreateLinkOutput, error) {
 	ctx, span := tracer.Start(ctx, "usecase CreateLink")
 	defer span.End()
 
-	var (
-		id     = uuid.New()
-		alias  = base64.RawURLEncoding.EncodeToString(id[:])
-		output dto.CreateLinkOutput
-	)
+	var output dto.CreateLinkOutput
 
-	link := entity.Link{
-		ID:        id,
-		URL:       input.URL,
-		Alias:     alias,
-		ExpiredAt: time.Now().Add(linkTTL),
+	link, err := u.newLink(ctx, input)
+	if err != nil {
+		return output, err
 	}
 
-	err := u.database.CreateLink(ctx, link)
-	if err != nil {
-		return output, fmt.Errorf("u.database.CreateLink: %w", err)
+	stored, err := u.insert(ctx, link, input)
+	switch {
+	case errors.Is(err, entity.ErrAliasTaken):
+		return output, err
+	case errors.Is(err, entity.ErrAlreadyExist):
+		return output.Load(stored, u.shortURL.Build(stored.Alias)), err
+	case err != nil:
+		return output, err
 	}
 
-	err = u.cache.PutLink(ctx, link)
+	err = u.cache.PutLink(ctx, stored)
 	if err != nil {
 		return output, fmt.Errorf("u.cache.PutLink: %w", err)
 	}
 
-	err = u.publisher.SendLink(ctx, link)
+	return output.Load(stored, u.shortURL.Build(stored.Alias)), nil
+}
+
+// CreateBatch creates the links of the batch and reports the result of each link, invalid links and taken aliases
+// don't fail the whole batch. The new links are inserted together, in dedup mode the links that may be shared
+// with the creators of the same URL are created one by one.
+//
+// The batch is not atomic: each dedup link and each round of the alias retries is committed in its own transaction,
+// so on an error the links committed before it are kept, although the error is returned for the whole batch.
+func (u *Usecase) CreateBatch(ctx context.Context, input dto.CreateLinksInput) (dto.CreateLinksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase CreateLinks")
+	defer span.End()
+
+	output := dto.CreateLinksOutput{Links: make([]dto.CreateLinksItem, len(input.Links))}
+	links := make([]entity.Link, len(input.Links))
+
+	var created, pending []int
+	for i := range input.Links {
+		item := &output.Links[i]
+		item.Index = i
+
+		err := input.Links[i].Validate()
+		if err == nil {
+			links[i], err = u.newLink(ctx, input.Links[i])
+		}
+		if err != nil {
+			item.Err = err
+			continue
+		}
+
+		if !u.dedup(input.Links[i]) {
+			pending = append(pending, i)
+			continue
+		}
+
+		stored, err := u.insert(ctx, links[i], input.Links[i])
+		switch {
+		case errors.Is(err, entity.ErrAlreadyExist):
+			link := dto.CreateLinkOutput{}.Load(stored, u.shortURL.Build(stored.Alias))
+			item.Link, item.Existing = &link, true
+		case err != nil:
+			item.Err = err
+		default:
+			links[i] = stored
+			created = append(created, i)
+		}
+	}
+
+	inserted, err := u.insertBatch(ctx, input.Links, links, pending, output.Links)
+	if err != nil {
+		return output, err
+	}
+	created = append(created, inserted...)
+
+	if len(created) > 0 {
+		newLinks := make([]entity.Link, 0, len(created))
+		for _, i := range created {
+			newLinks = append(newLinks, links[i])
+		}
+
+		err = u.cache.PutLinks(ctx, newLinks...)
+		if err != nil {
+			return output, fmt.Errorf("u.cache.PutLinks: %w", err)
+		}
+	}
+
+	for _, i := range created {
+		link := dto.CreateLinkOutput{}.Load(links[i], u.shortURL.Build(links[i].Alias))
+		output.Links[i].Link = &link
+	}
+	for i := range output.Links {
+		switch {
+		case output.Links[i].Err != nil:
+			output.Failed++
+		case !output.Links[i].Existing:
+			output.Created++
+		}
+	}
+
+	return output, nil
+}
+
+// insertBatch inserts the pending links together and returns the indexes of the inserted ones.
+// The links with generated aliases that are already taken are retried with new aliases,
+// the errors of the links that are not inserted are set to their items.
+func (u *Usecase) insertBatch(
+	ctx context.Context, inputs []dto.CreateLinkInput, links []entity.Link, pending []int, items []dto.CreateLinksItem,
+) ([]int, error) {
+	var inserted []int
+
+	for attempt := 1; len(pending) > 0; attempt++ {
+		var (
+			batch   = make([]entity.Link, 0, len(pending))
+			indexes = make([]int, 0, len(pending))
+			taken   []int
+		)
+		for _, i := range pending {
+			if inputs[i].Alias == "" {
+				alias, err := u.generator.Generate(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("u.generator.Generate: %w", err)
+				}
+				links[i].Alias = alias
+			}
+			// custom aliases are validated, only generated ones may be reserved
+			if entity.IsReservedAlias(links[i].Alias) {
+				taken = append(taken, i)
+				continue
+			}
+			batch = append(batch, links[i])
+			indexes = append(indexes, i)
+		}
+
+		if len(batch) > 0 {
+			created, err := u.database.CreateLinks(ctx, batch)
+			if err != nil {
+				return nil, fmt.Errorf("u.database.CreateLinks: %w", err)
+			}
+			for j, i := range indexes {
+				if created[j] {
+					inserted = append(inserted, i)
+				} else {
+					taken = append(taken, i)
+				}
+			}
+		}
+
+		pending = pending[:0]
+		for _, i := range taken {
+			if inputs[i].Alias != "" || attempt >= u.config.AliasAttempts {
+				items[i].Err = fmt.Errorf("alias %q: %w", links[i].Alias, entity.ErrAliasTaken)
+				continue
+			}
+			pending = append(pending, i)
+		}
+		if len(pending) > 0 {
+			log.Warn().Int("links", len(pending)).Int("attempt", attempt).Msg("u.database.CreateLinks: generated aliases are already taken")
+		}
+	}
+
+	return inserted, nil
+}
+
+// newLink returns the link of the input to be stored, the alias is generated on insert if not set.
+func (u *Usecase) newLink(ctx context.Context, input dto.CreateLinkInput) (entity.Link, error) {
+	if u.blocklist.IsBlocked(input.URL) {
+		return entity.Link{}, entity.ErrBlocked
+	}
+
+	expiredAt, err := u.config.Lifetime().ExpiredAt(input.Expiration(), time.Now())
 	if err != nil {
-		return output, fmt.Errorf("u.broker.SendLink: %w", err)
+		return entity.Link{}, fmt.Errorf("lifetime.ExpiredAt: %w", err)
+	}
+
+	link := entity.Link{
+		ID:           uuid.New(),
+		URL:          input.URL,
+		Alias:        input.Alias,
+		ExpiredAt:    expiredAt,
+		RedirectCode: input.RedirectCode,
+		ForwardQuery: input.ForwardQuery,
+		MaxClicks:    input.MaxClicks,
+		OwnerID:      entity.OwnerFromContext(ctx),
+		CreatedAt:    time.Now().UTC(),
+	}
+	if link.RedirectCode == 0 {
+		link.RedirectCode = u.config.RedirectCode
+	}
+	if input.Password != "" {
+		link.PasswordHash, err = entity.HashPassword(input.Password)
+		if err != nil {
+			return entity.Link{}, fmt.Errorf("entity.HashPassword: %w", err)
+		}
+	}
+
+	return link, nil
+}
+
+// insert stores the link, a new alias is generated while the generated one is already taken.
+func (u *Usecase) insert(ctx context.Context, link entity.Link, input dto.CreateLinkInput) (entity.Link, error) {
+	var (
+		stored entity.Link
+		err    error
+	)
+
+	for attempt := 1; ; attempt++ {
+		if input.Alias == "" {
+			link.Alias, err = u.generator.Generate(ctx)
+			if err != nil {
+				return stored, fmt.Errorf("u.generator.Generate: %w", err)
+			}
+		}
+
+		if entity.IsReservedAlias(link.Alias) {
+			err = fmt.Errorf("alias %q is reserved: %w", link.Alias, entity.ErrAliasTaken)
+		} else {
+			stored, err = u.store(ctx, link, u.dedup(input))
+		}
+		if input.Alias != "" || attempt >= u.config.AliasAttempts || !errors.Is(err, entity.ErrAliasTaken) {
+			return stored, err
+		}
+		log.Warn().Err(err).Int("attempt", attempt).Msg("u.store: generated alias is already taken")
+	}
+}
+
+// dedup tells whether the existing link of the URL is returned instead of creating a new one,
+// protected and limited links are never shared with the creators of the same URL.
+func (u *Usecase) dedup(input dto.CreateLinkInput) bool {
+	return u.config.Dedup && input.Alias == "" && input.Password == "" && input.MaxClicks == 0
+}
+
+// store saves the link. In dedup mode an unexpired link of the same owner with the same URL
+// is returned instead along with entity.ErrAlreadyExist.
+func (u *Usecase) store(ctx context.Context, link entity.Link, dedup bool) (entity.Link, error) {
+	if dedup {
+		stored, err := u.database.FindOrCreateLink(ctx, link)
+		if err != nil {
+			return stored, fmt.Errorf("u.database.FindOrCreateLink: %w", err)
+		}
+		return stored, nil
+	}
+
+	err := u.database.CreateLink(ctx, link)
+	if err != nil {
+		return entity.Link{}, fmt.Errorf("u.database.CreateLink: %w", err)
+	}
+
+	return link, nil
+}
+
+func (u *Usecase) CheckAlias(ctx context.Context, input dto.CheckAliasInput) (dto.CheckAliasOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase CheckAlias")
+	defer span.End()
+
+	output := dto.CheckAliasOutput{Alias: input.Alias}
+
+	_, err := u.database.FindLink(ctx, input.Alias, "")
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		output.Available = true
+	case err != nil:
+		return output, fmt.Errorf("u.database.FindLink: %w", err)
 	}
 
-	return output.Load(link), nil
+	return output, nil
 }
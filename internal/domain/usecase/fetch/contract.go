@@ -2,6 +2,7 @@ package fetch
 
 import (
 	"context"
+	"time"
 
 	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
 )
@@ -10,9 +11,19 @@ import (
 
 type database interface {
 	FindLink(ctx context.Context, alias string, url string) (*entity.Link, error)
+	ConsumeClick(ctx context.Context, alias string) (int, error)
 }
 
 type cache interface {
 	GetLink(ctx context.Context, alias string) (*entity.Link, error)
 	PutLink(context.Context, entity.Link) error
+	ConsumeClick(ctx context.Context, alias string) (int, error)
+	RefundClick(ctx context.Context, alias string) error
+	PutClicksLeft(ctx context.Context, link entity.Link, left int) error
+	AddPasswordAttempt(ctx context.Context, alias, client string, window time.Duration) (int, error)
+	ResetPasswordAttempts(ctx context.Context, alias, client string) error
+}
+
+type shortURLBuilder interface {
+	Build(alias string) string
 }
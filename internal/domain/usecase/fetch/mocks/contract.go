@@ -12,6 +12,7 @@ package mock_fetch
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 
@@ -42,6 +43,21 @@ func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
 	return m.recorder
 }
 
+// ConsumeClick mocks base method.
+func (m *Mockdatabase) ConsumeClick(ctx context.Context, alias string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeClick", ctx, alias)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeClick indicates an expected call of ConsumeClick.
+func (mr *MockdatabaseMockRecorder) ConsumeClick(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeClick", reflect.TypeOf((*Mockdatabase)(nil).ConsumeClick), ctx, alias)
+}
+
 // FindLink mocks base method.
 func (m *Mockdatabase) FindLink(ctx context.Context, alias, url string) (*entity.Link, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +97,36 @@ func (m *Mockcache) EXPECT() *MockcacheMockRecorder {
 	return m.recorder
 }
 
+// AddPasswordAttempt mocks base method.
+func (m *Mockcache) AddPasswordAttempt(ctx context.Context, alias, client string, window time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPasswordAttempt", ctx, alias, client, window)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPasswordAttempt indicates an expected call of AddPasswordAttempt.
+func (mr *MockcacheMockRecorder) AddPasswordAttempt(ctx, alias, client, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPasswordAttempt", reflect.TypeOf((*Mockcache)(nil).AddPasswordAttempt), ctx, alias, client, window)
+}
+
+// ConsumeClick mocks base method.
+func (m *Mockcache) ConsumeClick(ctx context.Context, alias string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeClick", ctx, alias)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeClick indicates an expected call of ConsumeClick.
+func (mr *MockcacheMockRecorder) ConsumeClick(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeClick", reflect.TypeOf((*Mockcache)(nil).ConsumeClick), ctx, alias)
+}
+
 // GetLink mocks base method.
 func (m *Mockcache) GetLink(ctx context.Context, alias string) (*entity.Link, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +142,20 @@ func (mr *MockcacheMockRecorder) GetLink(ctx, alias any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLink", reflect.TypeOf((*Mockcache)(nil).GetLink), ctx, alias)
 }
 
+// PutClicksLeft mocks base method.
+func (m *Mockcache) PutClicksLeft(ctx context.Context, link entity.Link, left int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutClicksLeft", ctx, link, left)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutClicksLeft indicates an expected call of PutClicksLeft.
+func (mr *MockcacheMockRecorder) PutClicksLeft(ctx, link, left any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutClicksLeft", reflect.TypeOf((*Mockcache)(nil).PutClicksLeft), ctx, link, left)
+}
+
 // PutLink mocks base method.
 func (m *Mockcache) PutLink(arg0 context.Context, arg1 entity.Link) error {
 	m.ctrl.T.Helper()
@@ -109,3 +169,69 @@ func (mr *MockcacheMockRecorder) PutLink(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLink", reflect.TypeOf((*Mockcache)(nil).PutLink), arg0, arg1)
 }
+
+// RefundClick mocks base method.
+func (m *Mockcache) RefundClick(ctx context.Context, alias string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundClick", ctx, alias)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundClick indicates an expected call of RefundClick.
+func (mr *MockcacheMockRecorder) RefundClick(ctx, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundClick", reflect.TypeOf((*Mockcache)(nil).RefundClick), ctx, alias)
+}
+
+// ResetPasswordAttempts mocks base method.
+func (m *Mockcache) ResetPasswordAttempts(ctx context.Context, alias, client string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordAttempts", ctx, alias, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPasswordAttempts indicates an expected call of ResetPasswordAttempts.
+func (mr *MockcacheMockRecorder) ResetPasswordAttempts(ctx, alias, client any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordAttempts", reflect.TypeOf((*Mockcache)(nil).ResetPasswordAttempts), ctx, alias, client)
+}
+
+// MockshortURLBuilder is a mock of shortURLBuilder interface.
+type MockshortURLBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockshortURLBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockshortURLBuilderMockRecorder is the mock recorder for MockshortURLBuilder.
+type MockshortURLBuilderMockRecorder struct {
+	mock *MockshortURLBuilder
+}
+
+// NewMockshortURLBuilder creates a new mock instance.
+func NewMockshortURLBuilder(ctrl *gomock.Controller) *MockshortURLBuilder {
+	mock := &MockshortURLBuilder{ctrl: ctrl}
+	mock.recorder = &MockshortURLBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshortURLBuilder) EXPECT() *MockshortURLBuilderMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockshortURLBuilder) Build(alias string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", alias)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockshortURLBuilderMockRecorder) Build(alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockshortURLBuilder)(nil).Build), alias)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -13,13 +14,36 @@ import (
 	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
 )
 
+const (
+	passwordMaxAttempts = 5
+	passwordLockout     = 15 * time.Minute
+)
+
+type Config struct {
+	// PasswordMaxAttempts limits wrong passwords per link and client within PasswordLockout
+	PasswordMaxAttempts int           `env:"LINK_PASSWORD_MAX_ATTEMPTS, default=5"`
+	PasswordLockout     time.Duration `env:"LINK_PASSWORD_LOCKOUT, default=15m"`
+	// RedirectMaxAge limits how long clients may cache permanent redirects, links can be updated, deleted
+	// or blocked at any time, so it is kept short. 0 - permanent redirects are not cached
+	RedirectMaxAge time.Duration `env:"LINK_REDIRECT_MAX_AGE, default=5m"`
+}
+
 type Usecase struct {
+	config   Config
 	database database
 	cache    cache
+	shortURL shortURLBuilder
 }
 
-func New(d database, c cache) Usecase {
-	return Usecase{database: d, cache: c}
+func New(cfg Config, d database, c cache, s shortURLBuilder) Usecase {
+	if cfg.PasswordMaxAttempts < 1 {
+		cfg.PasswordMaxAttempts = passwordMaxAttempts
+	}
+	if cfg.PasswordLockout <= 0 {
+		cfg.PasswordLockout = passwordLockout
+	}
+
+	return Usecase{config: cfg, database: d, cache: c, shortURL: s}
 }
 
 func (u *Usecase) Fetch(ctx context.Context, input dto.FetchLinkInput) (dto.FetchLinkOutput, error) {
@@ -28,17 +52,159 @@ func (u *Usecase) Fetch(ctx context.Context, input dto.FetchLinkInput) (dto.Fetc
 
 	var output dto.FetchLinkOutput
 
-	link, err := u.cache.GetLink(ctx, input.Alias)
+	link, err := u.link(ctx, input.Alias)
+	if err != nil {
+		return output, err
+	}
+
+	output = output.Load(link, u.shortURL.Build(link.Alias))
+
+	// the owner sees the target of a protected link without the password
+	if link.IsOwnedBy(entity.OwnerFromContext(ctx)) {
+		output.URL = link.URL
+	}
+
+	return output, nil
+}
+
+// Redirect returns the link to redirect to, a click of a limited link is consumed.
+// The URL of a protected link is not returned and the click is not consumed until Unlock.
+func (u *Usecase) Redirect(ctx context.Context, input dto.FetchLinkInput) (dto.FetchLinkOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase Redirect")
+	defer span.End()
+
+	var output dto.FetchLinkOutput
+
+	link, err := u.link(ctx, input.Alias)
+	if err != nil {
+		return output, err
+	}
+
+	if link.Blocked {
+		return output, entity.ErrBlocked
+	}
+
+	if !link.IsProtected() {
+		err = u.consume(ctx, link)
+		if err != nil {
+			return output, err
+		}
+	}
+
+	output = output.Load(link, u.shortURL.Build(link.Alias))
+	output.CacheMaxAge = max(u.config.RedirectMaxAge, 0)
+
+	return output, nil
+}
+
+// Unlock returns the link with its URL if the password is correct.
+// Password attempts are counted per link and client, after PasswordMaxAttempts
+// wrong ones the client gets entity.ErrTooManyAttempts until the lockout ends.
+func (u *Usecase) Unlock(ctx context.Context, input dto.UnlockLinkInput) (dto.FetchLinkOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase UnlockLink")
+	defer span.End()
+
+	var output dto.FetchLinkOutput
+
+	link, err := u.link(ctx, input.Alias)
+	if err != nil {
+		return output, err
+	}
+
+	if link.Blocked {
+		return output, entity.ErrBlocked
+	}
+
+	if link.IsProtected() {
+		// the attempt is reserved before the password check, so concurrent guesses cannot exceed the limit;
+		// the throttling fails open, so the links stay available without the cache
+		attempts, err := u.cache.AddPasswordAttempt(ctx, link.Alias, input.Client, u.config.PasswordLockout)
+		if err != nil {
+			log.Error().Err(err).Msg("u.cache.AddPasswordAttempt")
+		}
+		if attempts > u.config.PasswordMaxAttempts {
+			return output, entity.ErrTooManyAttempts
+		}
+
+		if !link.CheckPassword(input.Password) {
+			return output, entity.ErrInvalidPassword
+		}
+
+		err = u.cache.ResetPasswordAttempts(ctx, link.Alias, input.Client)
+		if err != nil {
+			log.Error().Err(err).Msg("u.cache.ResetPasswordAttempts")
+		}
+	}
+
+	err = u.consume(ctx, link)
+	if err != nil {
+		return output, err
+	}
+
+	output = output.Load(link, u.shortURL.Build(link.Alias))
+	output.URL = link.URL
+
+	return output, nil
+}
+
+// consume takes a click of a limited link, entity.ErrExhausted is returned when no clicks are left.
+// Postgres keeps the authoritative counter, the copy in the cache rejects exhausted links
+// without a database write and is put back from Postgres after eviction.
+func (u *Usecase) consume(ctx context.Context, link *entity.Link) error {
+	if !link.IsLimited() {
+		return nil
+	}
+
+	_, err := u.cache.ConsumeClick(ctx, link.Alias)
+	missing := errors.Is(err, entity.ErrNotFound)
+	taken := err == nil
+	switch {
+	case errors.Is(err, entity.ErrExhausted):
+		return err
+	case err != nil && !missing:
+		log.Error().Err(err).Msg("u.cache.ConsumeClick")
+	}
+
+	left, err := u.database.ConsumeClick(ctx, link.Alias)
+	if err != nil && !errors.Is(err, entity.ErrExhausted) {
+		if taken {
+			// the click is not taken in Postgres, so the cached counter must not lose it either
+			if err := u.cache.RefundClick(ctx, link.Alias); err != nil {
+				log.Error().Err(err).Msg("u.cache.RefundClick")
+			}
+		}
+		return fmt.Errorf("u.database.ConsumeClick: %w", err)
+	}
+
+	if missing {
+		if err := u.cache.PutClicksLeft(ctx, *link, left); err != nil {
+			log.Error().Err(err).Msg("u.cache.PutClicksLeft")
+		}
+	}
+
+	return err
+}
+
+// link returns an unexpired link from the cache or the database.
+func (u *Usecase) link(ctx context.Context, alias string) (*entity.Link, error) {
+	link, err := u.cache.GetLink(ctx, alias)
 	if err != nil && !errors.Is(err, entity.ErrNotFound) {
 		log.Error().Err(err).Msg("u.cache.GetLink")
 	}
 	if link != nil {
-		return output.Load(link), nil
+		if link.IsExpired(time.Now()) {
+			return nil, entity.ErrExpired
+		}
+		return link, nil
 	}
 
-	link, err = u.database.FindLink(ctx, input.Alias, "")
+	link, err = u.database.FindLink(ctx, alias, "")
 	if err != nil {
-		return output, fmt.Errorf("u.database.FindLink: %w", err)
+		return nil, fmt.Errorf("u.database.FindLink: %w", err)
+	}
+
+	if link.IsExpired(time.Now()) {
+		return nil, entity.ErrExpired
 	}
 
 	err = u.cache.PutLink(ctx, *link)
@@ -46,5 +212,5 @@ func (u *Usecase) Fetch(ctx context.Context, input dto.FetchLinkInput) (dto.Fetc
 		log.Error().Err(err).Msg("u.cache.PutLink")
 	}
 
-	return output.Load(link), nil
+	return link, nil
 }
@@ -0,0 +1,17 @@
+package list
+
+import (
+	"context"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	ListLinks(ctx context.Context, f entity.LinkFilter) ([]entity.Link, error)
+}
+
+type shortURLBuilder interface {
+	Build(alias string) string
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_list is a generated GoMock package.
+package mock_list
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// Mockdatabase is a mock of database interface.
+type Mockdatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockdatabaseMockRecorder is the mock recorder for Mockdatabase.
+type MockdatabaseMockRecorder struct {
+	mock *Mockdatabase
+}
+
+// NewMockdatabase creates a new mock instance.
+func NewMockdatabase(ctrl *gomock.Controller) *Mockdatabase {
+	mock := &Mockdatabase{ctrl: ctrl}
+	mock.recorder = &MockdatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
+	return m.recorder
+}
+
+// ListLinks mocks base method.
+func (m *Mockdatabase) ListLinks(ctx context.Context, f entity.LinkFilter) ([]entity.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLinks", ctx, f)
+	ret0, _ := ret[0].([]entity.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLinks indicates an expected call of ListLinks.
+func (mr *MockdatabaseMockRecorder) ListLinks(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLinks", reflect.TypeOf((*Mockdatabase)(nil).ListLinks), ctx, f)
+}
+
+// MockshortURLBuilder is a mock of shortURLBuilder interface.
+type MockshortURLBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockshortURLBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockshortURLBuilderMockRecorder is the mock recorder for MockshortURLBuilder.
+type MockshortURLBuilderMockRecorder struct {
+	mock *MockshortURLBuilder
+}
+
+// NewMockshortURLBuilder creates a new mock instance.
+func NewMockshortURLBuilder(ctrl *gomock.Controller) *MockshortURLBuilder {
+	mock := &MockshortURLBuilder{ctrl: ctrl}
+	mock.recorder = &MockshortURLBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshortURLBuilder) EXPECT() *MockshortURLBuilderMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockshortURLBuilder) Build(alias string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", alias)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockshortURLBuilderMockRecorder) Build(alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockshortURLBuilder)(nil).Build), alias)
+}
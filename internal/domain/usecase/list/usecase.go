@@ -0,0 +1,59 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+// defaultLimit is the default size of a page of links.
+const defaultLimit = 50
+
+type Usecase struct {
+	database database
+	shortURL shortURLBuilder
+}
+
+func New(d database, s shortURLBuilder) Usecase {
+	return Usecase{database: d, shortURL: s}
+}
+
+// List returns a page of links and the cursor of the next page.
+// Authenticated callers list only their own links regardless of the owner filter.
+func (u *Usecase) List(ctx context.Context, input dto.ListLinksInput) (dto.ListLinksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase ListLinks")
+	defer span.End()
+
+	var output dto.ListLinksOutput
+
+	owner := entity.OwnerFromContext(ctx)
+	if owner != uuid.Nil {
+		input.OwnerID = owner
+	}
+
+	f, err := input.Filter(defaultLimit)
+	if err != nil {
+		return output, fmt.Errorf("input.Filter: %w", err)
+	}
+
+	// one more link tells whether there is a next page
+	limit := f.Limit
+	f.Limit++
+
+	links, err := u.database.ListLinks(ctx, f)
+	if err != nil {
+		return output, fmt.Errorf("u.database.ListLinks: %w", err)
+	}
+
+	hasMore := len(links) > limit
+	if hasMore {
+		links = links[:limit]
+	}
+
+	return output.Load(f, links, hasMore, owner, u.shortURL.Build), nil
+}
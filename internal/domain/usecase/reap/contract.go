@@ -0,0 +1,18 @@
+package reap
+
+import (
+	"context"
+	"time"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	DeleteExpiredLinks(ctx context.Context, before time.Time, limit int) ([]entity.Link, error)
+}
+
+type cache interface {
+	DeleteLinks(ctx context.Context, aliases ...string) error
+}
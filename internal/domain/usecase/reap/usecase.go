@@ -0,0 +1,55 @@
+package reap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+type Usecase struct {
+	database database
+	cache    cache
+}
+
+func New(d database, c cache) Usecase {
+	return Usecase{database: d, cache: c}
+}
+
+// Reap deletes links expired before input.Before in batches of input.BatchSize
+// until no expired links are left, and evicts them from the cache.
+// The deleted events of the links are written to the outbox along with the deletion.
+func (u *Usecase) Reap(ctx context.Context, input dto.ReapLinksInput) (dto.ReapLinksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase ReapLinks")
+	defer span.End()
+
+	var output dto.ReapLinksOutput
+
+	for {
+		links, err := u.database.DeleteExpiredLinks(ctx, input.Before, input.BatchSize)
+		if err != nil {
+			return output, fmt.Errorf("u.database.DeleteExpiredLinks: %w", err)
+		}
+
+		if len(links) > 0 {
+			output.Reaped += len(links)
+
+			aliases := make([]string, 0, len(links))
+			for _, l := range links {
+				aliases = append(aliases, l.Alias)
+			}
+
+			err = u.cache.DeleteLinks(ctx, aliases...)
+			if err != nil {
+				log.Error().Err(err).Msg("u.cache.DeleteLinks")
+			}
+		}
+
+		if len(links) < input.BatchSize || ctx.Err() != nil {
+			return output, nil
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package relay
+
+import (
+	"context"
+	"time"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	RelayLinkEvents(
+		ctx context.Context,
+		limit int,
+		publish func(context.Context, []entity.LinkEvent) error,
+		backoff func(attempt int) time.Duration,
+	) (int, error)
+	LinkEventsStats(ctx context.Context) (entity.OutboxStats, error)
+}
+
+type publisher interface {
+	SendLinkEvents(ctx context.Context, events ...entity.LinkEvent) error
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_relay is a generated GoMock package.
+package mock_relay
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// Mockdatabase is a mock of database interface.
+type Mockdatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockdatabaseMockRecorder is the mock recorder for Mockdatabase.
+type MockdatabaseMockRecorder struct {
+	mock *Mockdatabase
+}
+
+// NewMockdatabase creates a new mock instance.
+func NewMockdatabase(ctrl *gomock.Controller) *Mockdatabase {
+	mock := &Mockdatabase{ctrl: ctrl}
+	mock.recorder = &MockdatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
+	return m.recorder
+}
+
+// LinkEventsStats mocks base method.
+func (m *Mockdatabase) LinkEventsStats(ctx context.Context) (entity.OutboxStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkEventsStats", ctx)
+	ret0, _ := ret[0].(entity.OutboxStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkEventsStats indicates an expected call of LinkEventsStats.
+func (mr *MockdatabaseMockRecorder) LinkEventsStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkEventsStats", reflect.TypeOf((*Mockdatabase)(nil).LinkEventsStats), ctx)
+}
+
+// RelayLinkEvents mocks base method.
+func (m *Mockdatabase) RelayLinkEvents(ctx context.Context, limit int, publish func(context.Context, []entity.LinkEvent) error, backoff func(int) time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayLinkEvents", ctx, limit, publish, backoff)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayLinkEvents indicates an expected call of RelayLinkEvents.
+func (mr *MockdatabaseMockRecorder) RelayLinkEvents(ctx, limit, publish, backoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayLinkEvents", reflect.TypeOf((*Mockdatabase)(nil).RelayLinkEvents), ctx, limit, publish, backoff)
+}
+
+// Mockpublisher is a mock of publisher interface.
+type Mockpublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockpublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockpublisherMockRecorder is the mock recorder for Mockpublisher.
+type MockpublisherMockRecorder struct {
+	mock *Mockpublisher
+}
+
+// NewMockpublisher creates a new mock instance.
+func NewMockpublisher(ctrl *gomock.Controller) *Mockpublisher {
+	mock := &Mockpublisher{ctrl: ctrl}
+	mock.recorder = &MockpublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockpublisher) EXPECT() *MockpublisherMockRecorder {
+	return m.recorder
+}
+
+// SendLinkEvents mocks base method.
+func (m *Mockpublisher) SendLinkEvents(ctx context.Context, events ...entity.LinkEvent) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendLinkEvents", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendLinkEvents indicates an expected call of SendLinkEvents.
+func (mr *MockpublisherMockRecorder) SendLinkEvents(ctx any, events ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendLinkEvents", reflect.TypeOf((*Mockpublisher)(nil).SendLinkEvents), varargs...)
+}
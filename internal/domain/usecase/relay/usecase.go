@@ -0,0 +1,79 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/backoff"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+const (
+	minBackoff = time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+type Config struct {
+	// MinBackoff delays the first retry of an event, each next retry waits twice as long up to MaxBackoff
+	MinBackoff time.Duration `env:"OUTBOX_RETRY_MIN_BACKOFF, default=1s"`
+	MaxBackoff time.Duration `env:"OUTBOX_RETRY_MAX_BACKOFF, default=5m"`
+}
+
+type Usecase struct {
+	backoff   backoff.Exponential
+	database  database
+	publisher publisher
+}
+
+func New(cfg Config, d database, p publisher) Usecase {
+	b := backoff.Exponential{Min: cfg.MinBackoff, Max: cfg.MaxBackoff}.
+		WithDefaults(backoff.Exponential{Min: minBackoff, Max: maxBackoff})
+
+	return Usecase{backoff: b, database: d, publisher: p}
+}
+
+// Relay publishes the link events of the outbox in batches of input.BatchSize until no events are due.
+// The events are published at least once: failed events are retried with exponential backoff
+// and are never dropped. The outbox lag is reported even if the broker is unavailable.
+func (u *Usecase) Relay(ctx context.Context, input dto.RelayEventsInput) (dto.RelayEventsOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase RelayEvents")
+	defer span.End()
+
+	var (
+		output   dto.RelayEventsOutput
+		relayErr error
+	)
+
+	for {
+		published, err := u.database.RelayLinkEvents(ctx, input.BatchSize, u.publish, u.backoff.Delay)
+		output.Published += published
+		if err != nil {
+			relayErr = fmt.Errorf("u.database.RelayLinkEvents: %w", err)
+			break
+		}
+
+		if published < input.BatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	stats, err := u.database.LinkEventsStats(ctx)
+	if err != nil {
+		return output, errors.Join(relayErr, fmt.Errorf("u.database.LinkEventsStats: %w", err))
+	}
+
+	output.Pending = stats.Pending
+	if !stats.OldestAt.IsZero() {
+		output.Lag = max(0, time.Since(stats.OldestAt))
+	}
+
+	return output, relayErr
+}
+
+func (u *Usecase) publish(ctx context.Context, events []entity.LinkEvent) error {
+	return u.publisher.SendLinkEvents(ctx, events...)
+}
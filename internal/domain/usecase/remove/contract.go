@@ -0,0 +1,19 @@
+package remove
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	DeleteLink(ctx context.Context, alias string, owner uuid.UUID) (entity.Link, error)
+}
+
+type cache interface {
+	DeleteLinks(ctx context.Context, aliases ...string) error
+}
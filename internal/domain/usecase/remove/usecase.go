@@ -0,0 +1,41 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+type Usecase struct {
+	database database
+	cache    cache
+}
+
+func New(d database, c cache) Usecase {
+	return Usecase{database: d, cache: c}
+}
+
+// Delete removes the link along with a deletion event and evicts it from the cache.
+// Links of other owners are reported as not found.
+func (u *Usecase) Delete(ctx context.Context, input dto.DeleteLinkInput) error {
+	ctx, span := tracer.Start(ctx, "usecase DeleteLink")
+	defer span.End()
+
+	link, err := u.database.DeleteLink(ctx, input.Alias, entity.OwnerFromContext(ctx))
+	if err != nil {
+		return fmt.Errorf("u.database.DeleteLink: %w", err)
+	}
+
+	// the link is already deleted, the cached copy expires by its TTL
+	err = u.cache.DeleteLinks(ctx, link.Alias)
+	if err != nil {
+		log.Error().Err(err).Msg("u.cache.DeleteLinks")
+	}
+
+	return nil
+}
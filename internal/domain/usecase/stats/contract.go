@@ -0,0 +1,29 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	FindLink(ctx context.Context, alias, url string) (*entity.Link, error)
+	SaveClicks(ctx context.Context, clicks []entity.Click) error
+	GetLinkStats(ctx context.Context, linkID uuid.UUID, now time.Time, top int) (entity.LinkStats, error)
+	GetClickHistogram(ctx context.Context, linkID uuid.UUID, from, to time.Time) ([]entity.ClickBucket, error)
+	RollupClicks(ctx context.Context, before time.Time) error
+	DeleteClicks(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+type geoLocator interface {
+	Country(ip string) string
+}
+
+type userAgentParser interface {
+	Parse(ua string) entity.UserAgent
+}
@@ -0,0 +1,209 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_stats is a generated GoMock package.
+package mock_stats
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// Mockdatabase is a mock of database interface.
+type Mockdatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockdatabaseMockRecorder is the mock recorder for Mockdatabase.
+type MockdatabaseMockRecorder struct {
+	mock *Mockdatabase
+}
+
+// NewMockdatabase creates a new mock instance.
+func NewMockdatabase(ctrl *gomock.Controller) *Mockdatabase {
+	mock := &Mockdatabase{ctrl: ctrl}
+	mock.recorder = &MockdatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteClicks mocks base method.
+func (m *Mockdatabase) DeleteClicks(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClicks", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteClicks indicates an expected call of DeleteClicks.
+func (mr *MockdatabaseMockRecorder) DeleteClicks(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClicks", reflect.TypeOf((*Mockdatabase)(nil).DeleteClicks), ctx, before, limit)
+}
+
+// FindLink mocks base method.
+func (m *Mockdatabase) FindLink(ctx context.Context, alias, url string) (*entity.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLink", ctx, alias, url)
+	ret0, _ := ret[0].(*entity.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLink indicates an expected call of FindLink.
+func (mr *MockdatabaseMockRecorder) FindLink(ctx, alias, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLink", reflect.TypeOf((*Mockdatabase)(nil).FindLink), ctx, alias, url)
+}
+
+// GetClickHistogram mocks base method.
+func (m *Mockdatabase) GetClickHistogram(ctx context.Context, linkID uuid.UUID, from, to time.Time) ([]entity.ClickBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClickHistogram", ctx, linkID, from, to)
+	ret0, _ := ret[0].([]entity.ClickBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClickHistogram indicates an expected call of GetClickHistogram.
+func (mr *MockdatabaseMockRecorder) GetClickHistogram(ctx, linkID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClickHistogram", reflect.TypeOf((*Mockdatabase)(nil).GetClickHistogram), ctx, linkID, from, to)
+}
+
+// GetLinkStats mocks base method.
+func (m *Mockdatabase) GetLinkStats(ctx context.Context, linkID uuid.UUID, now time.Time, top int) (entity.LinkStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinkStats", ctx, linkID, now, top)
+	ret0, _ := ret[0].(entity.LinkStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinkStats indicates an expected call of GetLinkStats.
+func (mr *MockdatabaseMockRecorder) GetLinkStats(ctx, linkID, now, top any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkStats", reflect.TypeOf((*Mockdatabase)(nil).GetLinkStats), ctx, linkID, now, top)
+}
+
+// RollupClicks mocks base method.
+func (m *Mockdatabase) RollupClicks(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollupClicks", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollupClicks indicates an expected call of RollupClicks.
+func (mr *MockdatabaseMockRecorder) RollupClicks(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollupClicks", reflect.TypeOf((*Mockdatabase)(nil).RollupClicks), ctx, before)
+}
+
+// SaveClicks mocks base method.
+func (m *Mockdatabase) SaveClicks(ctx context.Context, clicks []entity.Click) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveClicks", ctx, clicks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveClicks indicates an expected call of SaveClicks.
+func (mr *MockdatabaseMockRecorder) SaveClicks(ctx, clicks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveClicks", reflect.TypeOf((*Mockdatabase)(nil).SaveClicks), ctx, clicks)
+}
+
+// MockgeoLocator is a mock of geoLocator interface.
+type MockgeoLocator struct {
+	ctrl     *gomock.Controller
+	recorder *MockgeoLocatorMockRecorder
+	isgomock struct{}
+}
+
+// MockgeoLocatorMockRecorder is the mock recorder for MockgeoLocator.
+type MockgeoLocatorMockRecorder struct {
+	mock *MockgeoLocator
+}
+
+// NewMockgeoLocator creates a new mock instance.
+func NewMockgeoLocator(ctrl *gomock.Controller) *MockgeoLocator {
+	mock := &MockgeoLocator{ctrl: ctrl}
+	mock.recorder = &MockgeoLocatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgeoLocator) EXPECT() *MockgeoLocatorMockRecorder {
+	return m.recorder
+}
+
+// Country mocks base method.
+func (m *MockgeoLocator) Country(ip string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Country", ip)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Country indicates an expected call of Country.
+func (mr *MockgeoLocatorMockRecorder) Country(ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Country", reflect.TypeOf((*MockgeoLocator)(nil).Country), ip)
+}
+
+// MockuserAgentParser is a mock of userAgentParser interface.
+type MockuserAgentParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserAgentParserMockRecorder
+	isgomock struct{}
+}
+
+// MockuserAgentParserMockRecorder is the mock recorder for MockuserAgentParser.
+type MockuserAgentParserMockRecorder struct {
+	mock *MockuserAgentParser
+}
+
+// NewMockuserAgentParser creates a new mock instance.
+func NewMockuserAgentParser(ctrl *gomock.Controller) *MockuserAgentParser {
+	mock := &MockuserAgentParser{ctrl: ctrl}
+	mock.recorder = &MockuserAgentParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserAgentParser) EXPECT() *MockuserAgentParserMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MockuserAgentParser) Parse(ua string) entity.UserAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", ua)
+	ret0, _ := ret[0].(entity.UserAgent)
+	return ret0
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockuserAgentParserMockRecorder) Parse(ua any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockuserAgentParser)(nil).Parse), ua)
+}
@@ -0,0 +1,199 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+// defaultTop is the default size of the breakdowns.
+const defaultTop = 10
+
+type Usecase struct {
+	database database
+	geo      geoLocator
+	parser   userAgentParser
+}
+
+func New(d database, g geoLocator, p userAgentParser) Usecase {
+	return Usecase{database: d, geo: g, parser: p}
+}
+
+// RecordClicks enriches a batch of clicks with the country, referrer domain
+// and user agent details and saves it, clicks of deleted links are skipped.
+func (u *Usecase) RecordClicks(ctx context.Context, input []dto.RecordClickInput) error {
+	ctx, span := tracer.Start(ctx, "usecase RecordClicks")
+	defer span.End()
+
+	clicks := make([]entity.Click, 0, len(input))
+	for _, i := range input {
+		ua := u.parser.Parse(i.UserAgent)
+		clicks = append(clicks, entity.Click{
+			Alias:          i.Alias,
+			ClickedAt:      i.ClickedAt,
+			Referrer:       i.Referrer,
+			UserAgent:      i.UserAgent,
+			Country:        u.geo.Country(i.IP),
+			ReferrerDomain: referrerDomain(i.Referrer),
+			Browser:        ua.Browser,
+			OS:             ua.OS,
+			Device:         ua.Device,
+		})
+	}
+
+	err := u.database.SaveClicks(ctx, clicks)
+	if err != nil {
+		return fmt.Errorf("u.database.SaveClicks: %w", err)
+	}
+
+	return nil
+}
+
+// Stats returns the click statistics of the link, links of other owners are reported as not found.
+func (u *Usecase) Stats(ctx context.Context, input dto.LinkStatsInput) (dto.LinkStatsOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase LinkStats")
+	defer span.End()
+
+	var output dto.LinkStatsOutput
+
+	link, err := u.database.FindLink(ctx, input.Alias, "")
+	if err != nil {
+		return output, fmt.Errorf("u.database.FindLink: %w", err)
+	}
+
+	if !link.CanBeManagedBy(entity.OwnerFromContext(ctx)) {
+		return output, fmt.Errorf("link.CanBeManagedBy: %w", entity.ErrNotFound)
+	}
+
+	top := input.Top
+	if top == 0 {
+		top = defaultTop
+	}
+
+	stats, err := u.database.GetLinkStats(ctx, link.ID, time.Now(), top)
+	if err != nil {
+		return output, fmt.Errorf("u.database.GetLinkStats: %w", err)
+	}
+
+	return output.Load(link.Alias, stats), nil
+}
+
+// Timeseries returns the clicks of the link bucketed by the interval in the requested time zone.
+// Empty buckets are included, so the histogram is continuous. Links of other owners are reported as not found.
+func (u *Usecase) Timeseries(ctx context.Context, input dto.LinkTimeseriesInput) (dto.LinkTimeseriesOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase LinkTimeseries")
+	defer span.End()
+
+	var output dto.LinkTimeseriesOutput
+
+	loc, err := time.LoadLocation(input.TimeZone)
+	if err != nil {
+		return output, fmt.Errorf("time.LoadLocation: %w", entity.NewValidationError("tz", err.Error()))
+	}
+
+	link, err := u.database.FindLink(ctx, input.Alias, "")
+	if err != nil {
+		return output, fmt.Errorf("u.database.FindLink: %w", err)
+	}
+
+	if !link.CanBeManagedBy(entity.OwnerFromContext(ctx)) {
+		return output, fmt.Errorf("link.CanBeManagedBy: %w", entity.ErrNotFound)
+	}
+
+	from := bucketStart(input.From, input.Interval, loc)
+	hourly, err := u.database.GetClickHistogram(ctx, link.ID, from, input.To)
+	if err != nil {
+		return output, fmt.Errorf("u.database.GetClickHistogram: %w", err)
+	}
+
+	var buckets []entity.ClickBucket
+	for start := from; start.Before(input.To); start = nextBucket(start, input.Interval) {
+		buckets = append(buckets, entity.ClickBucket{Start: start})
+	}
+
+	// both slices are ordered by time
+	i := 0
+	for _, h := range hourly {
+		start := bucketStart(h.Start, input.Interval, loc)
+		for i < len(buckets)-1 && !buckets[i+1].Start.After(start) {
+			i++
+		}
+		if i < len(buckets) && !start.Before(buckets[i].Start) {
+			buckets[i].Clicks += h.Clicks
+		}
+	}
+
+	return output.Load(link.Alias, input, buckets), nil
+}
+
+// Rollup counts the old raw clicks into the hourly rollups and then
+// deletes the raw clicks that are out of the retention period.
+func (u *Usecase) Rollup(ctx context.Context, input dto.RollupClicksInput) (dto.RollupClicksOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase RollupClicks")
+	defer span.End()
+
+	var output dto.RollupClicksOutput
+
+	err := u.database.RollupClicks(ctx, input.Before)
+	if err != nil {
+		return output, fmt.Errorf("u.database.RollupClicks: %w", err)
+	}
+
+	if input.RetainAfter.IsZero() {
+		return output, nil
+	}
+
+	for {
+		deleted, err := u.database.DeleteClicks(ctx, input.RetainAfter, input.BatchSize)
+		if err != nil {
+			return output, fmt.Errorf("u.database.DeleteClicks: %w", err)
+		}
+		output.Deleted += deleted
+
+		if deleted < int64(input.BatchSize) || ctx.Err() != nil {
+			return output, nil
+		}
+	}
+}
+
+// bucketStart returns the start of the bucket containing t in the location,
+// weeks start on Monday.
+func bucketStart(t time.Time, interval string, loc *time.Location) time.Time {
+	t = t.In(loc)
+	switch interval {
+	case dto.IntervalHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case dto.IntervalWeek:
+		monday := int(t.Weekday()+6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-monday, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+}
+
+// nextBucket returns the start of the following bucket, days and weeks follow the calendar across DST changes.
+func nextBucket(start time.Time, interval string) time.Time {
+	switch interval {
+	case dto.IntervalHour:
+		return start.Add(time.Hour)
+	case dto.IntervalWeek:
+		return start.AddDate(0, 0, 7)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// referrerDomain returns the referrer host without the www prefix, empty if the referrer is unknown.
+func referrerDomain(referrer string) string {
+	u, err := url.Parse(referrer)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
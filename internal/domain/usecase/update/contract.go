@@ -0,0 +1,27 @@
+package update
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go
+
+type database interface {
+	UpdateLink(ctx context.Context, alias string, changes entity.LinkChanges, owner uuid.UUID) (entity.Link, error)
+}
+
+type cache interface {
+	DeleteLinks(ctx context.Context, aliases ...string) error
+}
+
+type shortURLBuilder interface {
+	Build(alias string) string
+}
+
+type blocklist interface {
+	IsBlocked(url string) bool
+}
@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go
+//
+
+// Package mock_update is a generated GoMock package.
+package mock_update
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+
+	entity "github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+)
+
+// Mockdatabase is a mock of database interface.
+type Mockdatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockdatabaseMockRecorder
+	isgomock struct{}
+}
+
+// MockdatabaseMockRecorder is the mock recorder for Mockdatabase.
+type MockdatabaseMockRecorder struct {
+	mock *Mockdatabase
+}
+
+// NewMockdatabase creates a new mock instance.
+func NewMockdatabase(ctrl *gomock.Controller) *Mockdatabase {
+	mock := &Mockdatabase{ctrl: ctrl}
+	mock.recorder = &MockdatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockdatabase) EXPECT() *MockdatabaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateLink mocks base method.
+func (m *Mockdatabase) UpdateLink(ctx context.Context, alias string, changes entity.LinkChanges, owner uuid.UUID) (entity.Link, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLink", ctx, alias, changes, owner)
+	ret0, _ := ret[0].(entity.Link)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLink indicates an expected call of UpdateLink.
+func (mr *MockdatabaseMockRecorder) UpdateLink(ctx, alias, changes, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLink", reflect.TypeOf((*Mockdatabase)(nil).UpdateLink), ctx, alias, changes, owner)
+}
+
+// Mockcache is a mock of cache interface.
+type Mockcache struct {
+	ctrl     *gomock.Controller
+	recorder *MockcacheMockRecorder
+	isgomock struct{}
+}
+
+// MockcacheMockRecorder is the mock recorder for Mockcache.
+type MockcacheMockRecorder struct {
+	mock *Mockcache
+}
+
+// NewMockcache creates a new mock instance.
+func NewMockcache(ctrl *gomock.Controller) *Mockcache {
+	mock := &Mockcache{ctrl: ctrl}
+	mock.recorder = &MockcacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockcache) EXPECT() *MockcacheMockRecorder {
+	return m.recorder
+}
+
+// DeleteLinks mocks base method.
+func (m *Mockcache) DeleteLinks(ctx context.Context, aliases ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range aliases {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteLinks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLinks indicates an expected call of DeleteLinks.
+func (mr *MockcacheMockRecorder) DeleteLinks(ctx any, aliases ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, aliases...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLinks", reflect.TypeOf((*Mockcache)(nil).DeleteLinks), varargs...)
+}
+
+// MockshortURLBuilder is a mock of shortURLBuilder interface.
+type MockshortURLBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockshortURLBuilderMockRecorder
+	isgomock struct{}
+}
+
+// MockshortURLBuilderMockRecorder is the mock recorder for MockshortURLBuilder.
+type MockshortURLBuilderMockRecorder struct {
+	mock *MockshortURLBuilder
+}
+
+// NewMockshortURLBuilder creates a new mock instance.
+func NewMockshortURLBuilder(ctrl *gomock.Controller) *MockshortURLBuilder {
+	mock := &MockshortURLBuilder{ctrl: ctrl}
+	mock.recorder = &MockshortURLBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockshortURLBuilder) EXPECT() *MockshortURLBuilderMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockshortURLBuilder) Build(alias string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", alias)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockshortURLBuilderMockRecorder) Build(alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockshortURLBuilder)(nil).Build), alias)
+}
+
+// Mockblocklist is a mock of blocklist interface.
+type Mockblocklist struct {
+	ctrl     *gomock.Controller
+	recorder *MockblocklistMockRecorder
+	isgomock struct{}
+}
+
+// MockblocklistMockRecorder is the mock recorder for Mockblocklist.
+type MockblocklistMockRecorder struct {
+	mock *Mockblocklist
+}
+
+// NewMockblocklist creates a new mock instance.
+func NewMockblocklist(ctrl *gomock.Controller) *Mockblocklist {
+	mock := &Mockblocklist{ctrl: ctrl}
+	mock.recorder = &MockblocklistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockblocklist) EXPECT() *MockblocklistMockRecorder {
+	return m.recorder
+}
+
+// IsBlocked mocks base method.
+func (m *Mockblocklist) IsBlocked(url string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", url)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockblocklistMockRecorder) IsBlocked(url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*Mockblocklist)(nil).IsBlocked), url)
+}
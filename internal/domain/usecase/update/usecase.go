@@ -0,0 +1,69 @@
+package update
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/dto"
+	"github.com/xgmsx/go-url-shortener-ddd/internal/domain/entity"
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/observability/otel/tracer"
+)
+
+type Usecase struct {
+	lifetime  entity.Lifetime
+	database  database
+	cache     cache
+	shortURL  shortURLBuilder
+	blocklist blocklist
+}
+
+// New returns the usecase updating links, the new expiration follows the lifetime policy of the created links.
+func New(l entity.Lifetime, d database, c cache, s shortURLBuilder, b blocklist) Usecase {
+	return Usecase{lifetime: l, database: d, cache: c, shortURL: s, blocklist: b}
+}
+
+// Update changes the URL and/or expiration of the link along with an update event
+// and evicts the cached copy. Only the requested fields are changed, so concurrent updates
+// of different fields don't overwrite each other. Links of other owners are reported as not found.
+func (u *Usecase) Update(ctx context.Context, input dto.UpdateLinkInput) (dto.UpdateLinkOutput, error) {
+	ctx, span := tracer.Start(ctx, "usecase UpdateLink")
+	defer span.End()
+
+	var output dto.UpdateLinkOutput
+
+	changes := entity.LinkChanges{URL: input.URL}
+
+	if input.URL != "" && u.blocklist.IsBlocked(input.URL) {
+		return output, entity.ErrBlocked
+	}
+
+	if input.HasExpiration() {
+		expiredAt, err := u.lifetime.ExpiredAt(input.Expiration(), time.Now())
+		if err != nil {
+			return output, fmt.Errorf("lifetime.ExpiredAt: %w", err)
+		}
+		changes.SetExpiry, changes.ExpiredAt = true, expiredAt
+	}
+
+	owner := entity.OwnerFromContext(ctx)
+
+	link, err := u.database.UpdateLink(ctx, input.Alias, changes, owner)
+	if err != nil {
+		return output, fmt.Errorf("u.database.UpdateLink: %w", err)
+	}
+
+	err = u.cache.DeleteLinks(ctx, link.Alias)
+	if err != nil {
+		log.Error().Err(err).Msg("u.cache.DeleteLinks")
+	}
+
+	output = output.Load(link, u.shortURL.Build(link.Alias))
+	if link.IsOwnedBy(owner) {
+		output.URL = link.URL
+	}
+
+	return output, nil
+}
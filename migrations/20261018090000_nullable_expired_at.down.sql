@@ -0,0 +1,6 @@
+BEGIN;
+
+UPDATE links SET expired_at = 'infinity' WHERE expired_at IS NULL;
+ALTER TABLE links ALTER COLUMN expired_at SET NOT NULL;
+
+COMMIT;
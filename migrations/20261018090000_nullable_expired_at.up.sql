@@ -0,0 +1,6 @@
+BEGIN;
+
+-- NULL expired_at means that the link never expires
+ALTER TABLE links ALTER COLUMN expired_at DROP NOT NULL;
+
+COMMIT;
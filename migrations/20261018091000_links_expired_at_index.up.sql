@@ -0,0 +1,5 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS idx_links_expired_at ON links (expired_at) WHERE expired_at IS NOT NULL;
+
+COMMIT;
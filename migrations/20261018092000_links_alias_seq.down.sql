@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP SEQUENCE IF EXISTS links_alias_seq;
+
+COMMIT;
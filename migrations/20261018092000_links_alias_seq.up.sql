@@ -0,0 +1,6 @@
+BEGIN;
+
+-- used by ALIAS_STRATEGY=sequence; starts at 62^4 to produce 5-character base62 aliases
+CREATE SEQUENCE IF NOT EXISTS links_alias_seq START WITH 14776336;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS clicks(
+    id      BIGSERIAL PRIMARY KEY,
+    link_id UUID NOT NULL REFERENCES links (id) ON DELETE CASCADE,
+
+    referrer   TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    clicked_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_clicks_link_id_clicked_at ON clicks (link_id, clicked_at);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE clicks
+    DROP COLUMN IF EXISTS country,
+    DROP COLUMN IF EXISTS referrer_domain,
+    DROP COLUMN IF EXISTS browser,
+    DROP COLUMN IF EXISTS os,
+    DROP COLUMN IF EXISTS device;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE clicks
+    ADD COLUMN IF NOT EXISTS country         TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS referrer_domain TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS browser         TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS os              TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS device          TEXT NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_clicks_clicked_at;
+DROP TABLE IF EXISTS clicks_rollup_state;
+DROP TABLE IF EXISTS clicks_hourly;
+
+COMMIT;
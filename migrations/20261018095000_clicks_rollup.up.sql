@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS clicks_hourly(
+    link_id UUID NOT NULL REFERENCES links (id) ON DELETE CASCADE,
+    bucket  TIMESTAMPTZ NOT NULL,
+    clicks  BIGINT NOT NULL,
+
+    PRIMARY KEY (link_id, bucket)
+);
+
+-- raw clicks before rolled_up_to are already counted in clicks_hourly
+CREATE TABLE IF NOT EXISTS clicks_rollup_state(
+    id           BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    rolled_up_to TIMESTAMPTZ NOT NULL
+);
+
+INSERT INTO clicks_rollup_state (rolled_up_to) VALUES ('1970-01-01 00:00:00+00') ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_clicks_clicked_at ON clicks (clicked_at);
+
+COMMIT;
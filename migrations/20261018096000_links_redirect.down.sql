@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS redirect_code,
+    DROP COLUMN IF EXISTS forward_query;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS redirect_code SMALLINT NOT NULL DEFAULT 302,
+    ADD COLUMN IF NOT EXISTS forward_query BOOLEAN  NOT NULL DEFAULT FALSE;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE links DROP COLUMN IF EXISTS password_hash;
+
+COMMIT;
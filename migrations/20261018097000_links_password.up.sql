@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE links ADD COLUMN IF NOT EXISTS password_hash TEXT NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE links DROP COLUMN IF EXISTS clicks_left;
+ALTER TABLE links DROP COLUMN IF EXISTS max_clicks;
+
+COMMIT;
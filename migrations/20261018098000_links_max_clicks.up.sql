@@ -0,0 +1,7 @@
+BEGIN;
+
+-- max_clicks = 0 means that the link is not limited, clicks_left is only maintained for limited links
+ALTER TABLE links ADD COLUMN IF NOT EXISTS max_clicks INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE links ADD COLUMN IF NOT EXISTS clicks_left INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
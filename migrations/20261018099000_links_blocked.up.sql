@@ -0,0 +1,6 @@
+BEGIN;
+
+-- blocked links are matched by the blocklist and are not served by the redirect
+ALTER TABLE links ADD COLUMN IF NOT EXISTS blocked BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_links_owner_id;
+ALTER TABLE links DROP COLUMN IF EXISTS owner_id;
+DROP TABLE IF EXISTS api_keys;
+
+COMMIT;
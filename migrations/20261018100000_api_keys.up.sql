@@ -0,0 +1,19 @@
+BEGIN;
+
+-- only the sha256 hash of a key is stored, the key itself is shown once on creation
+CREATE TABLE IF NOT EXISTS api_keys(
+    id       UUID PRIMARY KEY,
+    owner_id UUID NOT NULL,
+    name     TEXT NOT NULL DEFAULT '',
+    key_hash TEXT NOT NULL UNIQUE,
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMPTZ
+);
+
+-- links created before the API keys and by the kafka consumer have no owner
+ALTER TABLE links ADD COLUMN IF NOT EXISTS owner_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_links_owner_id ON links (owner_id) WHERE owner_id IS NOT NULL;
+
+COMMIT;
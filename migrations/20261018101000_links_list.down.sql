@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS idx_links_owner_id ON links (owner_id) WHERE owner_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_links_owner_id_expiry_id;
+DROP INDEX IF EXISTS idx_links_expiry_id;
+DROP INDEX IF EXISTS idx_links_owner_id_created_at_id;
+DROP INDEX IF EXISTS idx_links_created_at_id;
+
+ALTER TABLE links DROP COLUMN IF EXISTS domain;
+
+COMMIT;
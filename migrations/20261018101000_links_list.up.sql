@@ -0,0 +1,17 @@
+BEGIN;
+
+-- host of the target URL for the domain filter of the link list
+ALTER TABLE links ADD COLUMN IF NOT EXISTS domain TEXT
+    GENERATED ALWAYS AS (lower(substring(url FROM '^[a-z][a-z0-9+.-]*://(\[[^]]*\]|[^/?#:]+)'))) STORED;
+
+-- keyset pagination of the link list, the hash indexes can't serve range scans;
+-- links that never expire go last in the expired_at order
+CREATE INDEX IF NOT EXISTS idx_links_created_at_id ON links (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_links_owner_id_created_at_id ON links (owner_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_links_expiry_id ON links ((COALESCE(expired_at, 'infinity'::timestamptz)), id);
+CREATE INDEX IF NOT EXISTS idx_links_owner_id_expiry_id ON links (owner_id, (COALESCE(expired_at, 'infinity'::timestamptz)), id);
+
+-- replaced by idx_links_owner_id_created_at_id
+DROP INDEX IF EXISTS idx_links_owner_id;
+
+COMMIT;
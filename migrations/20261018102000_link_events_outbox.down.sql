@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS link_events_outbox;
+
+COMMIT;
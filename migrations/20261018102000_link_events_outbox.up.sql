@@ -0,0 +1,18 @@
+BEGIN;
+
+-- events are written in the same transaction as the links and deleted once published to kafka
+CREATE TABLE IF NOT EXISTS link_events_outbox(
+    id    BIGSERIAL PRIMARY KEY,
+    event TEXT NOT NULL,
+    alias TEXT NOT NULL,
+    url   TEXT NOT NULL,
+
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    attempts        INTEGER DEFAULT 0 NOT NULL,
+    next_attempt_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_error      TEXT DEFAULT '' NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_link_events_outbox_next_attempt_at ON link_events_outbox (next_attempt_at, id);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE link_events_outbox DROP COLUMN IF EXISTS expired_at;
+ALTER TABLE link_events_outbox DROP COLUMN IF EXISTS event_id;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- event_id identifies the event for the consumers, expired_at is passed along with the link
+ALTER TABLE link_events_outbox ADD COLUMN IF NOT EXISTS event_id UUID NOT NULL DEFAULT gen_random_uuid();
+ALTER TABLE link_events_outbox ADD COLUMN IF NOT EXISTS expired_at TIMESTAMPTZ NULL;
+
+COMMIT;
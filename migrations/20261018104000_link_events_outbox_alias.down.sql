@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_link_events_outbox_alias;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- the relay looks up the earlier events of the same link to publish them in order
+CREATE INDEX IF NOT EXISTS idx_link_events_outbox_alias ON link_events_outbox (alias, id);
+
+COMMIT;
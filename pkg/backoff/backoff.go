@@ -0,0 +1,30 @@
+package backoff
+
+import "time"
+
+// Exponential doubles the delay of each next attempt from Min up to Max.
+type Exponential struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// WithDefaults replaces a non-positive Min with the Min of defaults and Max below Min
+// with the larger of the Max of defaults and Min.
+func (b Exponential) WithDefaults(defaults Exponential) Exponential {
+	if b.Min <= 0 {
+		b.Min = defaults.Min
+	}
+	if b.Max < b.Min {
+		b.Max = max(defaults.Max, b.Min)
+	}
+	return b
+}
+
+// Delay returns the delay before the retry of an operation failed attempt times.
+func (b Exponential) Delay(attempt int) time.Duration {
+	delay := b.Min
+	for i := 1; i < attempt && delay < b.Max; i++ {
+		delay *= 2
+	}
+	return min(delay, b.Max)
+}
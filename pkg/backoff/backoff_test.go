@@ -0,0 +1,38 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponentialDelay(t *testing.T) {
+	b := Exponential{Min: time.Second, Max: 5 * time.Minute}
+
+	assert.Equal(t, time.Second, b.Delay(0))
+	assert.Equal(t, time.Second, b.Delay(1))
+	assert.Equal(t, 4*time.Second, b.Delay(3))
+	assert.Equal(t, 5*time.Minute, b.Delay(10))
+	assert.Equal(t, 5*time.Minute, b.Delay(1000), "the delay doesn't overflow")
+}
+
+func TestExponentialWithDefaults(t *testing.T) {
+	defaults := Exponential{Min: time.Second, Max: time.Minute}
+
+	testCases := []struct {
+		name    string
+		backoff Exponential
+		want    Exponential
+	}{
+		{name: "Set", backoff: Exponential{Min: time.Millisecond, Max: time.Second}, want: Exponential{Min: time.Millisecond, Max: time.Second}},
+		{name: "Zero", backoff: Exponential{}, want: defaults},
+		{name: "Max below min", backoff: Exponential{Min: time.Hour, Max: time.Second}, want: Exponential{Min: time.Hour, Max: time.Hour}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.backoff.WithDefaults(defaults))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	keyHeader    = "x-api-key"
+	bearerPrefix = "bearer "
+)
+
+// AuthenticateFunc checks the key and returns the context of the call, e.g. with the principal.
+// The returned error is passed to the client as is, so it should be a status error.
+type AuthenticateFunc func(ctx context.Context, key string) (context.Context, error)
+
+// UnaryServerInterceptor rejects calls without a key in the "x-api-key" or "authorization: Bearer"
+// metadata with codes.Unauthenticated and calls the handler with the context returned by authenticate.
+func UnaryServerInterceptor(authenticate AuthenticateFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := Key(ctx)
+		if key == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing api key")
+		}
+
+		ctx, err := authenticate(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Key returns the key of the incoming metadata.
+func Key(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if v := md.Get(keyHeader); len(v) > 0 && strings.TrimSpace(v[0]) != "" {
+		return strings.TrimSpace(v[0])
+	}
+
+	for _, v := range md.Get("authorization") {
+		if len(v) > len(bearerPrefix) && strings.EqualFold(v[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(v[len(bearerPrefix):])
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type userKey struct{}
+
+func authenticate(ctx context.Context, key string) (context.Context, error) {
+	if key != "valid" {
+		return ctx, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	return context.WithValue(ctx, userKey{}, "user"), nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, _ any) (any, error) {
+		user, _ := ctx.Value(userKey{}).(string)
+		return "Hello, " + user, nil
+	}
+
+	testCases := []struct {
+		name     string
+		md       metadata.MD
+		wantCode codes.Code
+		wantResp any
+	}{
+		{
+			name:     "Key metadata",
+			md:       metadata.Pairs("x-api-key", "valid"),
+			wantResp: "Hello, user",
+		},
+		{
+			name:     "Bearer authorization",
+			md:       metadata.Pairs("authorization", "Bearer valid"),
+			wantResp: "Hello, user",
+		},
+		{
+			name:     "Missing key",
+			md:       metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Invalid key",
+			md:       metadata.Pairs("x-api-key", "invalid"),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	interceptor := UnaryServerInterceptor(authenticate)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+
+			resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			assert.Equal(t, tc.wantResp, resp)
+		})
+	}
+}
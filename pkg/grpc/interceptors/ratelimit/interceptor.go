@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
+)
+
+const (
+	HeaderLimit      = "x-ratelimit-limit"
+	HeaderRemaining  = "x-ratelimit-remaining"
+	HeaderReset      = "x-ratelimit-reset"
+	HeaderRetryAfter = "retry-after"
+)
+
+// Limiter takes a token from the bucket of the key, e.g. ratelimit.Limiter.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error)
+}
+
+// UnaryServerInterceptor limits the calls of each client returned by key, the peer IP if key is nil,
+// to the methods of the limits keyed by the full method name, e.g. "/shortener_v1.Shortener/CreateLink".
+// Limited calls are rejected with codes.ResourceExhausted and RetryInfo details, all counted calls
+// get the x-ratelimit-* header metadata. The calls are let through if the limiter fails.
+// Counting per API key requires the interceptor to be chained after the key is verified.
+func UnaryServerInterceptor(limiter Limiter, limits map[string]ratelimit.Limit, key func(ctx context.Context) string) grpc.UnaryServerInterceptor {
+	if key == nil {
+		key = func(ctx context.Context) string { return ratelimit.ClientKey("", PeerIP(ctx)) }
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		limit, ok := limits[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		res, err := limiter.Allow(ctx, info.FullMethod+":"+key(ctx), limit)
+		if err != nil {
+			log.Error().Err(err).Str("method", info.FullMethod).Msg("ratelimit: limiter.Allow")
+			return handler(ctx, req)
+		}
+
+		md := metadata.Pairs(
+			HeaderLimit, strconv.Itoa(res.Limit),
+			HeaderRemaining, strconv.Itoa(res.Remaining),
+			HeaderReset, strconv.FormatInt(ratelimit.Seconds(res.ResetAfter), 10),
+		)
+
+		if !res.Allowed {
+			md.Set(HeaderRetryAfter, strconv.FormatInt(ratelimit.Seconds(res.RetryAfter), 10))
+			_ = grpc.SetHeader(ctx, md)
+
+			st := status.New(codes.ResourceExhausted, "too many requests")
+			if withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(res.RetryAfter)}); err == nil {
+				st = withDetails
+			}
+			return nil, st.Err()
+		}
+
+		_ = grpc.SetHeader(ctx, md)
+		return handler(ctx, req)
+	}
+}
+
+// PeerIP returns the IP address of the client of the call, empty if unknown.
+func PeerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
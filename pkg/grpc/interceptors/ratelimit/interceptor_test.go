@@ -0,0 +1,158 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
+)
+
+// limiter allows the first Burst calls of each key.
+type limiter struct {
+	counts map[string]int
+	err    error
+}
+
+func (l *limiter) Allow(_ context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	if l.err != nil {
+		return ratelimit.Result{}, l.err
+	}
+
+	l.counts[key]++
+	remaining := max(0, limit.Burst-l.counts[key])
+	res := ratelimit.Result{Allowed: l.counts[key] <= limit.Burst, Limit: limit.Burst, Remaining: remaining, ResetAfter: limit.Period}
+	if !res.Allowed {
+		res.RetryAfter = limit.Period / time.Duration(limit.Burst)
+	}
+	return res, nil
+}
+
+// stream records the header metadata set by the interceptor.
+type stream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *stream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	handler := func(_ context.Context, _ any) (any, error) { return "OK", nil }
+	limits := map[string]ratelimit.Limit{
+		"/shortener_v1.Shortener/CreateLink": {Burst: 2, Period: time.Minute},
+		"POST /api/link":                     {Burst: 1, Period: time.Second},
+	}
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}
+
+	testCases := []struct {
+		name       string
+		method     string
+		md         metadata.MD
+		key        func(ctx context.Context) string
+		calls      int
+		limiterErr error
+		wantCode   codes.Code
+		wantHeader metadata.MD
+		wantRetry  time.Duration
+		wantKey    string
+	}{
+		{
+			name:       "Allowed",
+			method:     "/shortener_v1.Shortener/CreateLink",
+			calls:      2,
+			wantCode:   codes.OK,
+			wantHeader: metadata.Pairs(HeaderLimit, "2", HeaderRemaining, "0", HeaderReset, "60"),
+			wantKey:    "/shortener_v1.Shortener/CreateLink:ip:10.0.0.1",
+		},
+		{
+			name:       "Limited",
+			method:     "/shortener_v1.Shortener/CreateLink",
+			calls:      3,
+			wantCode:   codes.ResourceExhausted,
+			wantHeader: metadata.Pairs(HeaderLimit, "2", HeaderRemaining, "0", HeaderReset, "60", HeaderRetryAfter, "30"),
+			wantRetry:  30 * time.Second,
+		},
+		{
+			name:       "Unverified API key is counted per peer IP",
+			method:     "/shortener_v1.Shortener/CreateLink",
+			md:         metadata.Pairs("x-api-key", "sk_1"),
+			calls:      1,
+			wantCode:   codes.OK,
+			wantHeader: metadata.Pairs(HeaderLimit, "2", HeaderRemaining, "1", HeaderReset, "60"),
+			wantKey:    "/shortener_v1.Shortener/CreateLink:ip:10.0.0.1",
+		},
+		{
+			name:       "Counted per key of the caller",
+			method:     "/shortener_v1.Shortener/CreateLink",
+			key:        func(context.Context) string { return ratelimit.ClientKey("key1", "") },
+			calls:      1,
+			wantCode:   codes.OK,
+			wantHeader: metadata.Pairs(HeaderLimit, "2", HeaderRemaining, "1", HeaderReset, "60"),
+			wantKey:    "/shortener_v1.Shortener/CreateLink:key:key1",
+		},
+		{
+			name:     "Method without limit",
+			method:   "/shortener_v1.Shortener/FetchLink",
+			calls:    10,
+			wantCode: codes.OK,
+		},
+		{
+			name:       "Limiter error",
+			method:     "/shortener_v1.Shortener/CreateLink",
+			calls:      3,
+			limiterErr: errors.New("test redis error"),
+			wantCode:   codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := &limiter{counts: map[string]int{}, err: tc.limiterErr}
+			interceptor := UnaryServerInterceptor(l, limits, tc.key)
+
+			var (
+				s    *stream
+				resp any
+				err  error
+			)
+			for range tc.calls {
+				s = &stream{}
+				ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+				ctx = grpc.NewContextWithServerTransportStream(ctx, s)
+
+				resp, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			}
+
+			st := status.Convert(err)
+			assert.Equal(t, tc.wantCode, st.Code())
+			assert.Equal(t, tc.wantHeader, s.header)
+			if tc.wantCode == codes.OK {
+				assert.Equal(t, "OK", resp)
+			}
+			if tc.wantRetry > 0 {
+				require.Len(t, st.Details(), 1)
+				info, ok := st.Details()[0].(*errdetails.RetryInfo)
+				require.True(t, ok)
+				assert.Equal(t, tc.wantRetry, info.GetRetryDelay().AsDuration())
+			}
+			if tc.wantKey != "" {
+				assert.Contains(t, l.counts, tc.wantKey)
+			}
+		})
+	}
+}
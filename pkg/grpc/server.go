@@ -18,12 +18,21 @@ type Config struct {
 	Port string `env:"GRPC_PORT, default=50051"`
 }
 
+type Options struct {
+	UnaryInterceptors []grpc.UnaryServerInterceptor
+}
+
 type Server struct {
 	srv *grpc.Server
 }
 
-func New(controllers ...registrable) *Server {
-	srv := grpc.NewServer()
+func New(opts *Options, controllers ...registrable) *Server {
+	var serverOpts []grpc.ServerOption
+	if opts != nil && len(opts.UnaryInterceptors) > 0 {
+		serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(opts.UnaryInterceptors...))
+	}
+
+	srv := grpc.NewServer(serverOpts...)
 
 	for _, c := range controllers {
 		c.Register(srv)
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const defaultHeader = "X-API-Key"
+
+var ConfigDefault = Config{
+	Next:         nil,
+	Header:       defaultHeader,
+	Authenticate: nil,
+}
+
+type Config struct {
+	// Next skips the middleware when it returns true
+	Next func(c *fiber.Ctx) bool
+	// Header carries the key, "Authorization: Bearer <key>" is accepted as well
+	Header string
+	// Authenticate checks the key and returns the user context of the request, e.g. with the principal.
+	// The returned error is passed to the error handler as is.
+	Authenticate func(ctx context.Context, key string) (context.Context, error)
+}
+
+func configDefault(config ...Config) Config {
+	// Return default config if nothing provided
+	if len(config) < 1 {
+		return ConfigDefault
+	}
+
+	// Override default config
+	cfg := config[0]
+
+	// Set default values
+	if cfg.Header == "" {
+		cfg.Header = ConfigDefault.Header
+	}
+
+	return cfg
+}
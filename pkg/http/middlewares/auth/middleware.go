@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const bearerPrefix = "Bearer "
+
+// New returns a middleware that rejects requests without a key with 401 Unauthorized
+// and puts the context returned by Config.Authenticate to the user context of the request.
+func New(config ...Config) fiber.Handler {
+	// Set default config
+	cfg := configDefault(config...)
+	if cfg.Authenticate == nil {
+		panic("auth: Config.Authenticate is required")
+	}
+
+	// Return new handler
+	return func(c *fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		key := Key(c, cfg.Header)
+		if key == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, "missing api key")
+		}
+
+		ctx, err := cfg.Authenticate(c.UserContext(), key)
+		if err != nil {
+			return err
+		}
+
+		c.SetUserContext(ctx)
+		return c.Next()
+	}
+}
+
+// Key returns the key of the header or of the bearer authorization.
+func Key(c *fiber.Ctx, header string) string {
+	if key := strings.TrimSpace(c.Get(header)); key != "" {
+		return key
+	}
+
+	authorization := c.Get(fiber.HeaderAuthorization)
+	if len(authorization) > len(bearerPrefix) && strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(authorization[len(bearerPrefix):])
+	}
+
+	return ""
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type userKey struct{}
+
+func authenticate(ctx context.Context, key string) (context.Context, error) {
+	switch key {
+	case "valid":
+		return context.WithValue(ctx, userKey{}, "user"), nil
+	case "broken":
+		return ctx, errors.New("test db error")
+	default:
+		return ctx, fiber.NewError(fiber.StatusUnauthorized, "invalid api key")
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	app := fiber.New()
+	app.Use(New(Config{
+		Next:         func(c *fiber.Ctx) bool { return c.Path() == "/public" },
+		Authenticate: authenticate,
+	}))
+	handler := func(c *fiber.Ctx) error {
+		user, _ := c.UserContext().Value(userKey{}).(string)
+		return c.SendString("Hello, " + user)
+	}
+	app.Get("/private", handler)
+	app.Get("/public", handler)
+
+	testCases := []struct {
+		name       string
+		path       string
+		headers    map[string]string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "Key header",
+			path:       "/private",
+			headers:    map[string]string{"X-API-Key": "valid"},
+			wantStatus: http.StatusOK,
+			wantBody:   "Hello, user",
+		},
+		{
+			name:       "Bearer authorization",
+			path:       "/private",
+			headers:    map[string]string{"Authorization": "bearer valid"},
+			wantStatus: http.StatusOK,
+			wantBody:   "Hello, user",
+		},
+		{
+			name:       "Missing key",
+			path:       "/private",
+			headers:    map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   "missing api key",
+		},
+		{
+			name:       "Invalid key",
+			path:       "/private",
+			headers:    map[string]string{"X-API-Key": "invalid"},
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   "invalid api key",
+		},
+		{
+			name:       "Authenticate error",
+			path:       "/private",
+			headers:    map[string]string{"X-API-Key": "broken"},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "Skipped by Next",
+			path:       "/public",
+			wantStatus: http.StatusOK,
+			wantBody:   "Hello, ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.path, http.NoBody)
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantBody != "" {
+				assert.Equal(t, tc.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareWithoutAuthenticate(t *testing.T) {
+	assert.Panics(t, func() { New() })
+}
@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
+)
+
+// Limiter takes a token from the bucket of the key, e.g. ratelimit.Limiter.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error)
+}
+
+var ConfigDefault = Config{
+	Next:         nil,
+	Limiter:      nil,
+	Limits:       nil,
+	KeyGenerator: nil,
+}
+
+type Config struct {
+	skipPaths map[string]struct{}
+	// Next skips the middleware when it returns true
+	Next func(c *fiber.Ctx) bool
+	// Limiter counts the requests, required
+	Limiter Limiter
+	// Limits of the routes as "METHOD /path", path segments starting with ":" match any value,
+	// other segments match case-insensitively as the routes of Fiber. Other keys, e.g. gRPC methods, are ignored.
+	Limits map[string]ratelimit.Limit
+	// KeyGenerator returns the client the request is counted for, the client IP by default.
+	// Counting per API key requires the middleware to run after the key is verified.
+	KeyGenerator func(c *fiber.Ctx) string
+}
+
+func (c *Config) SetSkipPaths(paths ...string) *Config {
+	if len(paths) > 0 {
+		c.skipPaths = make(map[string]struct{}, len(paths))
+		for _, path := range paths {
+			c.skipPaths[path] = struct{}{}
+		}
+	}
+	return c
+}
+
+func configDefault(config ...Config) Config {
+	// Return default config if nothing provided
+	if len(config) < 1 {
+		return ConfigDefault
+	}
+
+	// Override default config
+	cfg := config[0]
+
+	// Set default values
+	if cfg.KeyGenerator == nil {
+		cfg.KeyGenerator = defaultKeyGenerator
+	}
+
+	return cfg
+}
@@ -0,0 +1,146 @@
+package ratelimit
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rs/zerolog/log"
+
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
+)
+
+const (
+	HeaderLimit     = "X-RateLimit-Limit"
+	HeaderRemaining = "X-RateLimit-Remaining"
+	HeaderReset     = "X-RateLimit-Reset"
+)
+
+type rule struct {
+	name     string
+	method   string
+	segments []string
+	limit    ratelimit.Limit
+}
+
+// New returns a middleware that limits the requests of each client to the routes of Config.Limits.
+// Limited requests are rejected with 429 Too Many Requests and the Retry-After header, all counted
+// requests get the X-RateLimit-* headers. The requests are let through if the limiter fails.
+func New(config ...Config) fiber.Handler {
+	// Set default config
+	cfg := configDefault(config...)
+	if cfg.Limiter == nil {
+		panic("ratelimit: Config.Limiter is required")
+	}
+
+	rules := parseRules(cfg.Limits)
+
+	// Return new handler
+	return func(c *fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+		if _, ok := cfg.skipPaths[c.Path()]; ok {
+			return c.Next()
+		}
+
+		r, ok := matchRule(rules, c.Method(), c.Path())
+		if !ok {
+			return c.Next()
+		}
+
+		res, err := cfg.Limiter.Allow(c.UserContext(), r.name+":"+cfg.KeyGenerator(c), r.limit)
+		if err != nil {
+			log.Error().Err(err).Str("rule", r.name).Msg("ratelimit: limiter.Allow")
+			return c.Next()
+		}
+
+		c.Set(HeaderLimit, strconv.Itoa(res.Limit))
+		c.Set(HeaderRemaining, strconv.Itoa(res.Remaining))
+		c.Set(HeaderReset, strconv.FormatInt(ratelimit.Seconds(res.ResetAfter), 10))
+
+		if !res.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.FormatInt(ratelimit.Seconds(res.RetryAfter), 10))
+			return fiber.NewError(fiber.StatusTooManyRequests, "too many requests")
+		}
+
+		return c.Next()
+	}
+}
+
+// defaultKeyGenerator counts the requests per client IP.
+func defaultKeyGenerator(c *fiber.Ctx) string {
+	return ratelimit.ClientKey("", c.IP())
+}
+
+// parseRules returns the HTTP rules of the limits, the rules with fewer parameters go first,
+// so a literal route takes precedence over a parametrized one.
+func parseRules(limits map[string]ratelimit.Limit) []rule {
+	rules := make([]rule, 0, len(limits))
+	for name, limit := range limits {
+		method, path, ok := strings.Cut(name, " ")
+		if !ok || !isMethod(method) {
+			continue
+		}
+		rules = append(rules, rule{
+			name:     name,
+			method:   strings.ToUpper(method),
+			segments: strings.Split(strings.Trim(strings.TrimSpace(path), "/"), "/"),
+			limit:    limit,
+		})
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		pi, pj := params(rules[i].segments), params(rules[j].segments)
+		if pi != pj {
+			return pi < pj
+		}
+		return rules[i].name < rules[j].name
+	})
+
+	return rules
+}
+
+func matchRule(rules []rule, method, path string) (rule, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for _, r := range rules {
+		if r.method != method || len(r.segments) != len(segments) {
+			continue
+		}
+
+		match := true
+		for i, s := range r.segments {
+			if !strings.HasPrefix(s, ":") && !strings.EqualFold(s, segments[i]) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return r, true
+		}
+	}
+
+	return rule{}, false
+}
+
+func params(segments []string) int {
+	n := 0
+	for _, s := range segments {
+		if strings.HasPrefix(s, ":") {
+			n++
+		}
+	}
+	return n
+}
+
+func isMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
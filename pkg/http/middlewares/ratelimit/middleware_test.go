@@ -0,0 +1,188 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/xgmsx/go-url-shortener-ddd/pkg/ratelimit"
+)
+
+// limiter allows the first Burst requests of each key.
+type limiter struct {
+	counts map[string]int
+	err    error
+}
+
+func (l *limiter) Allow(_ context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	if l.err != nil {
+		return ratelimit.Result{}, l.err
+	}
+
+	l.counts[key]++
+	remaining := max(0, limit.Burst-l.counts[key])
+	res := ratelimit.Result{Allowed: l.counts[key] <= limit.Burst, Limit: limit.Burst, Remaining: remaining, ResetAfter: limit.Period}
+	if !res.Allowed {
+		res.RetryAfter = limit.Period / time.Duration(limit.Burst)
+	}
+	return res, nil
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	testCases := []struct {
+		name        string
+		method      string
+		path        string
+		headers     map[string]string
+		keyFunc     func(c *fiber.Ctx) string
+		requests    int
+		limiterErr  error
+		wantStatus  int
+		wantHeaders map[string]string
+		wantKey     string
+	}{
+		{
+			name:        "Allowed",
+			method:      http.MethodPost,
+			path:        "/api/link",
+			requests:    2,
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{HeaderLimit: "2", HeaderRemaining: "0", HeaderReset: "60", fiber.HeaderRetryAfter: ""},
+			wantKey:     "POST /api/link:ip:0.0.0.0",
+		},
+		{
+			name:        "Limited",
+			method:      http.MethodPost,
+			path:        "/api/link",
+			requests:    3,
+			wantStatus:  http.StatusTooManyRequests,
+			wantHeaders: map[string]string{HeaderLimit: "2", HeaderRemaining: "0", HeaderReset: "60", fiber.HeaderRetryAfter: "30"},
+		},
+		{
+			name:        "Unverified API key is counted per client IP",
+			method:      http.MethodPost,
+			path:        "/api/link",
+			headers:     map[string]string{"X-API-Key": "sk_1"},
+			requests:    2,
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{HeaderRemaining: "0"},
+			wantKey:     "POST /api/link:ip:0.0.0.0",
+		},
+		{
+			name:        "Counted per generated key",
+			method:      http.MethodPost,
+			path:        "/api/link",
+			keyFunc:     func(*fiber.Ctx) string { return ratelimit.ClientKey("key1", "") },
+			requests:    2,
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{HeaderRemaining: "0"},
+			wantKey:     "POST /api/link:key:key1",
+		},
+		{
+			name:        "Path of other case",
+			method:      http.MethodPost,
+			path:        "/API/Link/",
+			requests:    3,
+			wantStatus:  http.StatusTooManyRequests,
+			wantHeaders: map[string]string{HeaderLimit: "2"},
+			wantKey:     "POST /api/link:ip:0.0.0.0",
+		},
+		{
+			name:        "Route with parameters",
+			method:      http.MethodGet,
+			path:        "/alias1",
+			requests:    1,
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{HeaderLimit: "10"},
+			wantKey:     "GET /:alias:ip:0.0.0.0",
+		},
+		{
+			name:        "Literal route takes precedence",
+			method:      http.MethodGet,
+			path:        "/promo",
+			requests:    2,
+			wantStatus:  http.StatusTooManyRequests,
+			wantHeaders: map[string]string{HeaderLimit: "1", fiber.HeaderRetryAfter: "1"},
+		},
+		{
+			name:        "Route without limit",
+			method:      http.MethodGet,
+			path:        "/api/link",
+			requests:    10,
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{HeaderLimit: ""},
+		},
+		{
+			name:        "Skipped path",
+			method:      http.MethodGet,
+			path:        "/live",
+			requests:    20,
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{HeaderLimit: ""},
+		},
+		{
+			name:        "Limiter error",
+			method:      http.MethodPost,
+			path:        "/api/link",
+			requests:    3,
+			limiterErr:  errors.New("test redis error"),
+			wantStatus:  http.StatusOK,
+			wantHeaders: map[string]string{HeaderLimit: ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := &limiter{counts: map[string]int{}, err: tc.limiterErr}
+			cfg := Config{
+				Limiter:      l,
+				KeyGenerator: tc.keyFunc,
+				Limits: map[string]ratelimit.Limit{
+					"POST /api/link":                     {Burst: 2, Period: time.Minute},
+					"GET /:alias":                        {Burst: 10, Period: time.Minute},
+					"get /promo":                         {Burst: 1, Period: time.Second},
+					"/shortener_v1.Shortener/CreateLink": {Burst: 1, Period: time.Second},
+				},
+			}
+			cfg.SetSkipPaths("/live")
+
+			app := fiber.New()
+			app.Use(New(cfg))
+			app.All("/*", func(c *fiber.Ctx) error { return c.SendString("OK") })
+
+			var resp *http.Response
+			for range tc.requests {
+				req := httptest.NewRequest(tc.method, tc.path, http.NoBody)
+				for k, v := range tc.headers {
+					req.Header.Set(k, v)
+				}
+
+				var err error
+				resp, err = app.Test(req)
+				require.NoError(t, err)
+				_, _ = io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}
+
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			for k, v := range tc.wantHeaders {
+				assert.Equal(t, v, resp.Header.Get(k), k)
+			}
+			if tc.wantKey != "" {
+				assert.Contains(t, l.counts, tc.wantKey)
+			}
+		})
+	}
+}
+
+func TestRateLimitMiddlewareWithoutLimiter(t *testing.T) {
+	assert.Panics(t, func() { New() })
+}
@@ -27,6 +27,10 @@ type registrable interface {
 // @version      0.0.0
 // @title        Title
 // @BasePath     /api
+//
+// @securityDefinitions.apikey ApiKeyAuth
+// @in                         header
+// @name                       X-API-Key
 type Server struct {
 	App     *fiber.App
 	options *Options
@@ -47,15 +51,16 @@ func New(c Config, opts *Options, controllers ...registrable) *Server {
 		app.Use(m)
 	}
 
-	for _, c := range controllers {
-		c.Register(app)
-	}
-
+	// built-in routes go first, so they take precedence over root routes of the controllers
 	app.Get("/live", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
 	app.Get("/ready", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
 	app.Get("/swagger/*", swagger.HandlerDefault)
 	app.Get("/metrics", metrics.HandlerDefault)
 
+	for _, c := range controllers {
+		c.Register(app)
+	}
+
 	docs.SwaggerInfo.Title = c.AppName
 	docs.SwaggerInfo.Version = c.AppVersion
 
@@ -9,6 +9,8 @@ type Config struct {
 	Addr  []string `env:"KAFKA_BROKERS, required"`
 	Topic string   `env:"KAFKA_INPUT_TOPIC, required"`
 	Group string   `env:"KAFKA_GROUP, required"`
+	// DeadLetterTopic receives the messages of Topic that cannot be handled
+	DeadLetterTopic string `env:"KAFKA_DLQ_TOPIC, default=links-requested-dlq"`
 }
 
 type Reader struct {
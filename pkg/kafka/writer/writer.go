@@ -16,9 +16,12 @@ type Writer struct {
 
 func New(c *Config) (*Writer, error) {
 	w := &kafka.Writer{
-		Addr:     kafka.TCP(c.Addr...),
-		Topic:    c.Topic,
-		Balancer: &kafka.LeastBytes{},
+		Addr:  kafka.TCP(c.Addr...),
+		Topic: c.Topic,
+		// the messages of the same key go to the same partition and keep their order
+		Balancer: &kafka.Hash{},
+		// the write succeeds once all in-sync replicas have the messages, so they survive a broker failover
+		RequiredAcks: kafka.RequireAll,
 	}
 
 	return &Writer{Writer: w}, nil
@@ -0,0 +1,27 @@
+package writer
+
+import (
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequiresAllAcks(t *testing.T) {
+	w, err := New(&Config{Addr: []string{"localhost:9092"}, Topic: "links-created"})
+	require.NoError(t, err)
+
+	assert.Equal(t, kafka.RequireAll, w.RequiredAcks)
+}
+
+func TestBalancerKeepsKeyPartition(t *testing.T) {
+	w, err := New(&Config{Addr: []string{"localhost:9092"}, Topic: "links-created"})
+	require.NoError(t, err)
+
+	partitions := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	created := kafka.Message{Key: []byte("alias1"), Value: []byte("created")}
+	deleted := kafka.Message{Key: []byte("alias1"), Value: []byte("deleted, a much longer value than the created one")}
+
+	assert.Equal(t, w.Balancer.Balance(created, partitions...), w.Balancer.Balance(deleted, partitions...))
+}
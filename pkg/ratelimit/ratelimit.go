@@ -0,0 +1,152 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "ratelimit:"
+
+// scriptResults is the number of values returned by takeToken.
+const scriptResults = 4
+
+type Config struct {
+	Enabled bool `env:"RATE_LIMIT_ENABLED, default=true"`
+	// Rules limit the HTTP routes as "METHOD /path", path segments starting with ":" match any value,
+	// and the gRPC methods as "/package.Service/Method". Each client has its own bucket per rule.
+	Rules map[string]Limit `env:"RATE_LIMIT_RULES, delimiter=;, separator==, default=POST /api/shortener/link=60/1m;POST /api/shortener/links:batch=10/1m;GET /:alias=600/1m;GET /api/shortener/link/:alias/redirect=600/1m;/shortener_v1.Shortener/CreateLink=60/1m;/shortener_v1.Shortener/CreateLinks=10/1m"` //nolint:lll // default rules
+}
+
+// Limit is a token bucket of Burst tokens refilled at the rate of Burst tokens per Period,
+// e.g. "60/1m" allows 60 requests at once and then one request per second.
+type Limit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// ParseLimit parses a limit of the form "<burst>/<period>", the period is a duration
+// like 1m or 10s, the unit alone means one unit, e.g. "10/s".
+func ParseLimit(s string) (Limit, error) {
+	burst, period, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("limit %q: must be <burst>/<period>", s)
+	}
+
+	n, err := strconv.Atoi(burst)
+	if err != nil || n < 1 {
+		return Limit{}, fmt.Errorf("limit %q: burst must be a positive number", s)
+	}
+
+	if period != "" && !strings.ContainsAny(period[:1], "0123456789") {
+		period = "1" + period
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("limit %q: period must be a positive duration", s)
+	}
+
+	return Limit{Burst: n, Period: d}, nil
+}
+
+func (l *Limit) UnmarshalText(text []byte) error {
+	limit, err := ParseLimit(string(text))
+	if err != nil {
+		return err
+	}
+	*l = limit
+	return nil
+}
+
+func (l Limit) String() string {
+	return strconv.Itoa(l.Burst) + "/" + l.Period.String()
+}
+
+// Result of taking a token from the bucket.
+type Result struct {
+	Allowed    bool
+	Limit      int           // size of the bucket
+	Remaining  int           // tokens left in the bucket
+	RetryAfter time.Duration // until the next token if not allowed
+	ResetAfter time.Duration // until the bucket is full again
+}
+
+// Limiter keeps the token buckets in Redis, so the limits are shared by all instances of the service.
+type Limiter struct {
+	client redis.Scripter
+}
+
+func New(client redis.Scripter) *Limiter {
+	return &Limiter{client: client}
+}
+
+// takeToken refills the bucket by the time passed since the last call and takes a token if there is one.
+// The Redis clock is used, so the buckets don't depend on the clocks of the instances.
+// Returns allowed (0 or 1), tokens left, retry after and reset after in microseconds.
+var takeToken = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2]) / burst
+local clock = redis.call('TIME')
+local now = tonumber(clock[1]) * 1000000 + tonumber(clock[2])
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if not tokens or not ts then
+	tokens, ts = burst, now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) / interval)
+
+local allowed, retry = 0, 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) * interval)
+end
+local reset = math.ceil((burst - tokens) * interval)
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(reset / 1000) + 1000)
+
+return {allowed, math.floor(tokens), retry, reset}
+`)
+
+// Allow takes a token from the bucket of the key.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	res, err := takeToken.Run(ctx, l.client, []string{keyPrefix + key}, limit.Burst, limit.Period.Microseconds()).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("takeToken.Run: %w", err)
+	}
+	if len(res) != scriptResults {
+		return Result{}, fmt.Errorf("takeToken.Run: unexpected result %v", res)
+	}
+
+	return Result{
+		Allowed:    res[0] == 1,
+		Limit:      limit.Burst,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Microsecond,
+		ResetAfter: time.Duration(res[3]) * time.Microsecond,
+	}, nil
+}
+
+// ClientKey returns the client the requests are counted for: the authenticated principal, e.g. the id
+// of a verified API key, or the IP address of anonymous clients. Unverified credentials must not be
+// passed as the principal, otherwise every made-up key gets its own bucket.
+func ClientKey(principal, ip string) string {
+	if principal != "" {
+		return "key:" + principal
+	}
+	return "ip:" + ip
+}
+
+// Seconds rounds the duration up to whole seconds as in the Retry-After header.
+func Seconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
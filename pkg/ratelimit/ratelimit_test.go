@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimit(t *testing.T) {
+	testCases := []struct {
+		input     string
+		wantLimit Limit
+		wantErr   bool
+	}{
+		{input: "60/1m", wantLimit: Limit{Burst: 60, Period: time.Minute}},
+		{input: "10/s", wantLimit: Limit{Burst: 10, Period: time.Second}},
+		{input: " 5/90s ", wantLimit: Limit{Burst: 5, Period: 90 * time.Second}},
+		{input: "60", wantErr: true},
+		{input: "0/1m", wantErr: true},
+		{input: "x/1m", wantErr: true},
+		{input: "60/", wantErr: true},
+		{input: "60/-1m", wantErr: true},
+		{input: "60/week", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			limit, err := ParseLimit(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantLimit, limit)
+		})
+	}
+}
+
+func TestClientKey(t *testing.T) {
+	assert.Equal(t, "ip:10.0.0.1", ClientKey("", "10.0.0.1"))
+	assert.Equal(t, "key:key1", ClientKey("key1", "10.0.0.1"), "the principal takes precedence over the IP")
+	assert.NotEqual(t, ClientKey("key1", ""), ClientKey("key2", ""))
+}
+
+// scripter replies to the scripts with the result.
+type scripter struct {
+	redis.Scripter
+	result any
+	err    error
+	keys   []string
+	args   []any
+}
+
+func (s *scripter) EvalSha(_ context.Context, _ string, keys []string, args ...any) *redis.Cmd {
+	s.keys, s.args = keys, args
+	return redis.NewCmdResult(s.result, s.err)
+}
+
+func TestLimiterAllow(t *testing.T) {
+	limit := Limit{Burst: 60, Period: time.Minute}
+
+	testCases := []struct {
+		name       string
+		scripter   *scripter
+		wantResult Result
+		wantErr    bool
+	}{
+		{
+			name:       "Allowed",
+			scripter:   &scripter{result: []any{int64(1), int64(59), int64(0), int64(1_000_000)}},
+			wantResult: Result{Allowed: true, Limit: 60, Remaining: 59, ResetAfter: time.Second},
+		},
+		{
+			name:       "Limited",
+			scripter:   &scripter{result: []any{int64(0), int64(0), int64(500_000), int64(60_000_000)}},
+			wantResult: Result{Limit: 60, RetryAfter: 500 * time.Millisecond, ResetAfter: time.Minute},
+		},
+		{
+			name:     "Redis error",
+			scripter: &scripter{err: errors.New("test redis error")},
+			wantErr:  true,
+		},
+		{
+			name:     "Unexpected result",
+			scripter: &scripter{result: []any{int64(1)}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := New(tc.scripter).Allow(context.Background(), "rule:ip:10.0.0.1", limit)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, res)
+			assert.Equal(t, []string{"ratelimit:rule:ip:10.0.0.1"}, tc.scripter.keys)
+			assert.Equal(t, []any{60, int64(60_000_000)}, tc.scripter.args)
+		})
+	}
+}
+
+func TestSeconds(t *testing.T) {
+	assert.Equal(t, int64(0), Seconds(0))
+	assert.Equal(t, int64(1), Seconds(time.Millisecond))
+	assert.Equal(t, int64(2), Seconds(1500*time.Millisecond))
+}
@@ -20,8 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Shortener_CreateLink_FullMethodName = "/shortener_v1.Shortener/CreateLink"
-	Shortener_FetchLink_FullMethodName  = "/shortener_v1.Shortener/FetchLink"
+	Shortener_CreateLink_FullMethodName        = "/shortener_v1.Shortener/CreateLink"
+	Shortener_CreateLinks_FullMethodName       = "/shortener_v1.Shortener/CreateLinks"
+	Shortener_FetchLink_FullMethodName         = "/shortener_v1.Shortener/FetchLink"
+	Shortener_UpdateLink_FullMethodName        = "/shortener_v1.Shortener/UpdateLink"
+	Shortener_DeleteLink_FullMethodName        = "/shortener_v1.Shortener/DeleteLink"
+	Shortener_ListLinks_FullMethodName         = "/shortener_v1.Shortener/ListLinks"
+	Shortener_GetLinkStats_FullMethodName      = "/shortener_v1.Shortener/GetLinkStats"
+	Shortener_GetLinkTimeseries_FullMethodName = "/shortener_v1.Shortener/GetLinkTimeseries"
 )
 
 // ShortenerClient is the client API for Shortener service.
@@ -29,7 +35,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ShortenerClient interface {
 	CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*CreateLinkResponse, error)
+	CreateLinks(ctx context.Context, in *CreateLinksRequest, opts ...grpc.CallOption) (*CreateLinksResponse, error)
 	FetchLink(ctx context.Context, in *FetchLinkRequest, opts ...grpc.CallOption) (*FetchLinkResponse, error)
+	UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*UpdateLinkResponse, error)
+	DeleteLink(ctx context.Context, in *DeleteLinkRequest, opts ...grpc.CallOption) (*DeleteLinkResponse, error)
+	ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error)
+	GetLinkStats(ctx context.Context, in *GetLinkStatsRequest, opts ...grpc.CallOption) (*GetLinkStatsResponse, error)
+	GetLinkTimeseries(ctx context.Context, in *GetLinkTimeseriesRequest, opts ...grpc.CallOption) (*GetLinkTimeseriesResponse, error)
 }
 
 type shortenerClient struct {
@@ -50,6 +62,16 @@ func (c *shortenerClient) CreateLink(ctx context.Context, in *CreateLinkRequest,
 	return out, nil
 }
 
+func (c *shortenerClient) CreateLinks(ctx context.Context, in *CreateLinksRequest, opts ...grpc.CallOption) (*CreateLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLinksResponse)
+	err := c.cc.Invoke(ctx, Shortener_CreateLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortenerClient) FetchLink(ctx context.Context, in *FetchLinkRequest, opts ...grpc.CallOption) (*FetchLinkResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FetchLinkResponse)
@@ -60,12 +82,68 @@ func (c *shortenerClient) FetchLink(ctx context.Context, in *FetchLinkRequest, o
 	return out, nil
 }
 
+func (c *shortenerClient) UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*UpdateLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLinkResponse)
+	err := c.cc.Invoke(ctx, Shortener_UpdateLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortenerClient) DeleteLink(ctx context.Context, in *DeleteLinkRequest, opts ...grpc.CallOption) (*DeleteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLinkResponse)
+	err := c.cc.Invoke(ctx, Shortener_DeleteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortenerClient) ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinksResponse)
+	err := c.cc.Invoke(ctx, Shortener_ListLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortenerClient) GetLinkStats(ctx context.Context, in *GetLinkStatsRequest, opts ...grpc.CallOption) (*GetLinkStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLinkStatsResponse)
+	err := c.cc.Invoke(ctx, Shortener_GetLinkStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortenerClient) GetLinkTimeseries(ctx context.Context, in *GetLinkTimeseriesRequest, opts ...grpc.CallOption) (*GetLinkTimeseriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLinkTimeseriesResponse)
+	err := c.cc.Invoke(ctx, Shortener_GetLinkTimeseries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShortenerServer is the server API for Shortener service.
 // All implementations must embed UnimplementedShortenerServer
 // for forward compatibility.
 type ShortenerServer interface {
 	CreateLink(context.Context, *CreateLinkRequest) (*CreateLinkResponse, error)
+	CreateLinks(context.Context, *CreateLinksRequest) (*CreateLinksResponse, error)
 	FetchLink(context.Context, *FetchLinkRequest) (*FetchLinkResponse, error)
+	UpdateLink(context.Context, *UpdateLinkRequest) (*UpdateLinkResponse, error)
+	DeleteLink(context.Context, *DeleteLinkRequest) (*DeleteLinkResponse, error)
+	ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error)
+	GetLinkStats(context.Context, *GetLinkStatsRequest) (*GetLinkStatsResponse, error)
+	GetLinkTimeseries(context.Context, *GetLinkTimeseriesRequest) (*GetLinkTimeseriesResponse, error)
 	mustEmbedUnimplementedShortenerServer()
 }
 
@@ -79,9 +157,27 @@ type UnimplementedShortenerServer struct{}
 func (UnimplementedShortenerServer) CreateLink(context.Context, *CreateLinkRequest) (*CreateLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLink not implemented")
 }
+func (UnimplementedShortenerServer) CreateLinks(context.Context, *CreateLinksRequest) (*CreateLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLinks not implemented")
+}
 func (UnimplementedShortenerServer) FetchLink(context.Context, *FetchLinkRequest) (*FetchLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchLink not implemented")
 }
+func (UnimplementedShortenerServer) UpdateLink(context.Context, *UpdateLinkRequest) (*UpdateLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLink not implemented")
+}
+func (UnimplementedShortenerServer) DeleteLink(context.Context, *DeleteLinkRequest) (*DeleteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLink not implemented")
+}
+func (UnimplementedShortenerServer) ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
+}
+func (UnimplementedShortenerServer) GetLinkStats(context.Context, *GetLinkStatsRequest) (*GetLinkStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkStats not implemented")
+}
+func (UnimplementedShortenerServer) GetLinkTimeseries(context.Context, *GetLinkTimeseriesRequest) (*GetLinkTimeseriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkTimeseries not implemented")
+}
 func (UnimplementedShortenerServer) mustEmbedUnimplementedShortenerServer() {}
 func (UnimplementedShortenerServer) testEmbeddedByValue()                   {}
 
@@ -121,6 +217,24 @@ func _Shortener_CreateLink_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Shortener_CreateLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServer).CreateLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shortener_CreateLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServer).CreateLinks(ctx, req.(*CreateLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Shortener_FetchLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FetchLinkRequest)
 	if err := dec(in); err != nil {
@@ -139,6 +253,96 @@ func _Shortener_FetchLink_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Shortener_UpdateLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServer).UpdateLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shortener_UpdateLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServer).UpdateLink(ctx, req.(*UpdateLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shortener_DeleteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServer).DeleteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shortener_DeleteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServer).DeleteLink(ctx, req.(*DeleteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shortener_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServer).ListLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shortener_ListLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServer).ListLinks(ctx, req.(*ListLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shortener_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServer).GetLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shortener_GetLinkStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServer).GetLinkStats(ctx, req.(*GetLinkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shortener_GetLinkTimeseries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkTimeseriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServer).GetLinkTimeseries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shortener_GetLinkTimeseries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServer).GetLinkTimeseries(ctx, req.(*GetLinkTimeseriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Shortener_ServiceDesc is the grpc.ServiceDesc for Shortener service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,10 +354,34 @@ var Shortener_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateLink",
 			Handler:    _Shortener_CreateLink_Handler,
 		},
+		{
+			MethodName: "CreateLinks",
+			Handler:    _Shortener_CreateLinks_Handler,
+		},
 		{
 			MethodName: "FetchLink",
 			Handler:    _Shortener_FetchLink_Handler,
 		},
+		{
+			MethodName: "UpdateLink",
+			Handler:    _Shortener_UpdateLink_Handler,
+		},
+		{
+			MethodName: "DeleteLink",
+			Handler:    _Shortener_DeleteLink_Handler,
+		},
+		{
+			MethodName: "ListLinks",
+			Handler:    _Shortener_ListLinks_Handler,
+		},
+		{
+			MethodName: "GetLinkStats",
+			Handler:    _Shortener_GetLinkStats_Handler,
+		},
+		{
+			MethodName: "GetLinkTimeseries",
+			Handler:    _Shortener_GetLinkTimeseries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shortener_v1.proto",